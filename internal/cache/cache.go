@@ -0,0 +1,231 @@
+// Package cache keeps a workspace's users and channels on disk so that name
+// resolution and user lookups do not have to list the whole directory on
+// every run.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/jackchuka/slackcli/internal/config"
+	"github.com/jackchuka/slackcli/internal/slack"
+)
+
+// DefaultTTL is how long cached users and channels are trusted.
+const DefaultTTL = time.Hour
+
+// Options controls how commands use the cache.
+type Options struct {
+	// TTL is how long entries stay fresh; zero means DefaultTTL.
+	TTL time.Duration
+	// Disabled bypasses the cache entirely.
+	Disabled bool
+	// Refresh makes the services Wrap returns refetch what is on disk, once
+	// per run, and rewrite it. Status and Clear still see the file as it is.
+	Refresh bool
+}
+
+// DefaultDir returns the cache directory, next to the config file.
+func DefaultDir() string {
+	return filepath.Join(filepath.Dir(config.DefaultPath()), "cache")
+}
+
+type section[T any] struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	Items     []T       `json:"items"`
+}
+
+type contents struct {
+	Users    *section[slack.User]    `json:"users,omitempty"`
+	Channels *section[slack.Channel] `json:"channels,omitempty"`
+}
+
+// Cache is one workspace's cache file. It is safe for concurrent use, as
+// the MCP server needs, and rereads the file whenever another process has
+// changed it, so a long-running server sees a warm or clear run elsewhere
+// and never writes back a stale copy over it.
+type Cache struct {
+	path    string
+	ttl     time.Duration
+	refresh bool
+	now     func() time.Time
+
+	mu   sync.Mutex
+	file os.FileInfo // the file as last read or written; nil when absent
+	data contents
+}
+
+// New returns the cache for the workspace token belongs to, stored in dir
+// under a hash of the token so the token itself is never written out.
+func New(dir, token string, opts Options) *Cache {
+	sum := sha256.Sum256([]byte(token))
+	ttl := opts.TTL
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Cache{
+		path:    filepath.Join(dir, hex.EncodeToString(sum[:8])+".json"),
+		ttl:     ttl,
+		refresh: opts.Refresh,
+		now:     time.Now,
+	}
+}
+
+// Path returns the cache file's location.
+func (c *Cache) Path() string {
+	return c.path
+}
+
+// load brings the in-memory copy up to date with the file, reading it
+// again only when its modification time or size has changed. A missing or
+// unreadable file is an empty cache: the data can always be fetched again.
+func (c *Cache) load() {
+	info, err := os.Stat(c.path)
+	if err != nil {
+		c.file, c.data = nil, contents{}
+		return
+	}
+	if c.file != nil && info.ModTime().Equal(c.file.ModTime()) && info.Size() == c.file.Size() {
+		return
+	}
+	c.file, c.data = info, contents{}
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return
+	}
+	if err := json.Unmarshal(data, &c.data); err != nil {
+		c.data = contents{}
+	}
+}
+
+// save writes the cache through a temporary file, so concurrent runs never
+// see a half-written one.
+func (c *Cache) save() error {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(c.data)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), ".cache-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), c.path); err != nil {
+		return err
+	}
+	c.file, _ = os.Stat(c.path)
+	return nil
+}
+
+func fresh[T any](s *section[T], ttl time.Duration, now time.Time) bool {
+	return s != nil && now.Sub(s.FetchedAt) < ttl
+}
+
+// Users returns the cached users, and false when there are none or they
+// are older than the TTL.
+func (c *Cache) Users() ([]slack.User, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.load()
+	if !fresh(c.data.Users, c.ttl, c.now()) {
+		return nil, false
+	}
+	return c.data.Users.Items, true
+}
+
+// SetUsers replaces the cached users.
+func (c *Cache) SetUsers(users []slack.User) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.load()
+	c.data.Users = &section[slack.User]{FetchedAt: c.now(), Items: users}
+	return c.save()
+}
+
+// Channels returns the cached channels, and false when there are none or
+// they are older than the TTL.
+func (c *Cache) Channels() ([]slack.Channel, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.load()
+	if !fresh(c.data.Channels, c.ttl, c.now()) {
+		return nil, false
+	}
+	return c.data.Channels.Items, true
+}
+
+// SetChannels replaces the cached channels.
+func (c *Cache) SetChannels(channels []slack.Channel) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.load()
+	c.data.Channels = &section[slack.Channel]{FetchedAt: c.now(), Items: channels}
+	return c.save()
+}
+
+// InvalidateChannels drops the cached channels, for after a write that
+// changes them.
+func (c *Cache) InvalidateChannels() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.load()
+	if c.data.Channels == nil {
+		return nil
+	}
+	c.data.Channels = nil
+	return c.save()
+}
+
+// Clear deletes the cache file.
+func (c *Cache) Clear() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.file, c.data = nil, contents{}
+	if err := os.Remove(c.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Status describes what the cache holds.
+type Status struct {
+	Path              string    `json:"path"`
+	TTL               string    `json:"ttl"`
+	Users             int       `json:"users"`
+	UsersFetchedAt    time.Time `json:"users_fetched_at,omitzero"`
+	UsersFresh        bool      `json:"users_fresh"`
+	Channels          int       `json:"channels"`
+	ChannelsFetchedAt time.Time `json:"channels_fetched_at,omitzero"`
+	ChannelsFresh     bool      `json:"channels_fresh"`
+}
+
+func (c *Cache) Status() Status {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.load()
+	now := c.now()
+	s := Status{Path: c.path, TTL: c.ttl.String()}
+	if u := c.data.Users; u != nil {
+		s.Users, s.UsersFetchedAt, s.UsersFresh = len(u.Items), u.FetchedAt, fresh(u, c.ttl, now)
+	}
+	if ch := c.data.Channels; ch != nil {
+		s.Channels, s.ChannelsFetchedAt, s.ChannelsFresh = len(ch.Items), ch.FetchedAt, fresh(ch, c.ttl, now)
+	}
+	return s
+}
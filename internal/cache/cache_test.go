@@ -0,0 +1,127 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jackchuka/slackcli/internal/slack"
+)
+
+func newTestCache(t *testing.T, dir string, opts Options, now *time.Time) *Cache {
+	c := New(dir, "xoxb-test", opts)
+	c.now = func() time.Time { return *now }
+	return c
+}
+
+func TestCache(t *testing.T) {
+	now := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	users := []slack.User{{ID: "U1", Name: "alice"}}
+	channels := []slack.Channel{{ID: "C1", Name: "general"}}
+
+	t.Run("persists per token without writing the token", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, newTestCache(t, dir, Options{}, &now).SetUsers(users))
+
+		got, ok := newTestCache(t, dir, Options{}, &now).Users()
+		require.True(t, ok)
+		assert.Equal(t, users, got)
+
+		_, ok = New(dir, "xoxb-other", Options{}).Users()
+		assert.False(t, ok)
+
+		data, err := os.ReadFile(New(dir, "xoxb-test", Options{}).Path())
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "xoxb-test")
+	})
+
+	t.Run("expires after the TTL", func(t *testing.T) {
+		clock := now
+		c := newTestCache(t, t.TempDir(), Options{TTL: time.Minute}, &clock)
+		require.NoError(t, c.SetChannels(channels))
+
+		clock = now.Add(59 * time.Second)
+		_, ok := c.Channels()
+		assert.True(t, ok)
+
+		clock = now.Add(time.Minute)
+		_, ok = c.Channels()
+		assert.False(t, ok)
+		assert.False(t, c.Status().ChannelsFresh)
+	})
+
+	t.Run("refresh does not hide the file from status", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, newTestCache(t, dir, Options{}, &now).SetUsers(users))
+
+		status := newTestCache(t, dir, Options{Refresh: true}, &now).Status()
+		assert.Equal(t, 1, status.Users)
+		assert.True(t, status.UsersFresh)
+	})
+
+	t.Run("invalidate drops only channels", func(t *testing.T) {
+		dir := t.TempDir()
+		c := newTestCache(t, dir, Options{}, &now)
+		require.NoError(t, c.SetUsers(users))
+		require.NoError(t, c.SetChannels(channels))
+
+		require.NoError(t, c.InvalidateChannels())
+
+		status := newTestCache(t, dir, Options{}, &now).Status()
+		assert.Equal(t, 1, status.Users)
+		assert.Equal(t, 0, status.Channels)
+	})
+
+	t.Run("clear removes the file", func(t *testing.T) {
+		c := newTestCache(t, t.TempDir(), Options{}, &now)
+		require.NoError(t, c.Clear(), "clearing a missing cache is fine")
+		require.NoError(t, c.SetUsers(users))
+
+		require.NoError(t, c.Clear())
+
+		assert.NoFileExists(t, c.Path())
+		_, ok := c.Users()
+		assert.False(t, ok)
+	})
+
+	t.Run("sees changes made by another process", func(t *testing.T) {
+		dir := t.TempDir()
+		server := newTestCache(t, dir, Options{}, &now)
+		require.NoError(t, server.SetUsers(users))
+		other := newTestCache(t, dir, Options{}, &now)
+
+		require.NoError(t, other.SetChannels(channels))
+		got, ok := server.Channels()
+		require.True(t, ok)
+		assert.Equal(t, channels, got)
+
+		// Saving merges into the file rather than writing a stale copy.
+		require.NoError(t, other.Clear())
+		require.NoError(t, other.SetChannels(channels))
+		require.NoError(t, server.SetUsers(users))
+		status := newTestCache(t, dir, Options{}, &now).Status()
+		assert.Equal(t, 1, status.Users)
+		assert.Equal(t, 1, status.Channels)
+
+		require.NoError(t, other.Clear())
+		_, ok = server.Users()
+		assert.False(t, ok)
+	})
+
+	t.Run("corrupt file reads as empty", func(t *testing.T) {
+		dir := t.TempDir()
+		c := newTestCache(t, dir, Options{}, &now)
+		require.NoError(t, os.WriteFile(c.Path(), []byte("{not json"), 0o600))
+
+		_, ok := c.Users()
+		assert.False(t, ok)
+	})
+}
+
+func TestDefaultDir(t *testing.T) {
+	assert.Equal(t, "cache", filepath.Base(DefaultDir()))
+}
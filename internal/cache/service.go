@@ -0,0 +1,229 @@
+package cache
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"github.com/jackchuka/slackcli/internal/slack"
+)
+
+// Wrap returns svc with full user and channel listings served from c while
+// they are fresh, and stored in c when they are not. Lookups of single users,
+// by ID or email, are answered from a fresh listing too. Writes that change
+// channels drop the cached channels. Cache write failures are ignored: the
+// cache only ever saves calls.
+func Wrap(svc slack.Service, c *Cache) slack.Service {
+	s := &cachedService{Service: svc, c: c}
+	s.usersFetched.Store(!c.refresh)
+	s.channelsFetched.Store(!c.refresh)
+	return s
+}
+
+type cachedService struct {
+	slack.Service
+	c *Cache
+
+	// usersFetched and channelsFetched are false under Options.Refresh until
+	// this run has stored a listing of its own.
+	usersFetched    atomic.Bool
+	channelsFetched atomic.Bool
+}
+
+func (s *cachedService) users() ([]slack.User, bool) {
+	if !s.usersFetched.Load() {
+		return nil, false
+	}
+	return s.c.Users()
+}
+
+func (s *cachedService) setUsers(users []slack.User) error {
+	s.usersFetched.Store(true)
+	return s.c.SetUsers(users)
+}
+
+func (s *cachedService) channels() ([]slack.Channel, bool) {
+	if !s.channelsFetched.Load() {
+		return nil, false
+	}
+	return s.c.Channels()
+}
+
+func (s *cachedService) setChannels(channels []slack.Channel) error {
+	s.channelsFetched.Store(true)
+	return s.c.SetChannels(channels)
+}
+
+// fetchAll yields everything seq yields and, if the consumer reads to the
+// end without an error, hands the full listing to store.
+func fetchAll[T any](seq iter.Seq2[T, error], store func([]T) error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var all []T
+		for item, err := range seq {
+			if !yield(item, err) || err != nil {
+				return
+			}
+			all = append(all, item)
+		}
+		_ = store(all)
+	}
+}
+
+func fromSlice[T any](items []T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+func collect[T any](seq iter.Seq2[T, error]) (*slack.PaginatedResult[T], error) {
+	items := []T{}
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return &slack.PaginatedResult[T]{Items: items}, nil
+}
+
+func (s *cachedService) IterUsers(ctx context.Context, params slack.PaginationParams) iter.Seq2[slack.User, error] {
+	if params.Cursor != "" {
+		return s.Service.IterUsers(ctx, params)
+	}
+	if users, ok := s.users(); ok {
+		return fromSlice(users)
+	}
+	return fetchAll(s.Service.IterUsers(ctx, params), s.setUsers)
+}
+
+func (s *cachedService) ListUsers(ctx context.Context, params slack.PaginationParams) (*slack.PaginatedResult[slack.User], error) {
+	if !params.All || params.Cursor != "" {
+		return s.Service.ListUsers(ctx, params)
+	}
+	return collect(s.IterUsers(ctx, params))
+}
+
+func (s *cachedService) GetUserInfo(ctx context.Context, userID string) (*slack.User, error) {
+	if users, ok := s.users(); ok {
+		if i := slices.IndexFunc(users, func(u slack.User) bool { return u.ID == userID }); i >= 0 {
+			u := users[i]
+			return &u, nil
+		}
+	}
+	return s.Service.GetUserInfo(ctx, userID)
+}
+
+func (s *cachedService) GetUsers(ctx context.Context, userIDs []string) ([]slack.User, error) {
+	if users, ok := s.users(); ok {
+		byID := make(map[string]slack.User, len(users))
+		for _, u := range users {
+			byID[u.ID] = u
+		}
+		result := make([]slack.User, 0, len(userIDs))
+		for _, id := range userIDs {
+			u, ok := byID[id]
+			if !ok {
+				return s.Service.GetUsers(ctx, userIDs)
+			}
+			result = append(result, u)
+		}
+		return result, nil
+	}
+	return s.Service.GetUsers(ctx, userIDs)
+}
+
+func (s *cachedService) LookupUserByEmail(ctx context.Context, email string) (*slack.User, error) {
+	if users, ok := s.users(); ok {
+		if i := slices.IndexFunc(users, func(u slack.User) bool { return u.Email != "" && strings.EqualFold(u.Email, email) }); i >= 0 {
+			u := users[i]
+			return &u, nil
+		}
+	}
+	return s.Service.LookupUserByEmail(ctx, email)
+}
+
+// cachesChannels reports whether params asks for the full default listing,
+// which is what the cache holds.
+func cachesChannels(params slack.ListChannelsParams) bool {
+	return params.Pagination.Cursor == "" &&
+		(len(params.Types) == 0 || slices.Equal(params.Types, slack.DefaultChannelTypes))
+}
+
+func (s *cachedService) IterChannels(ctx context.Context, params slack.ListChannelsParams) iter.Seq2[slack.Channel, error] {
+	if !cachesChannels(params) {
+		return s.Service.IterChannels(ctx, params)
+	}
+	if channels, ok := s.channels(); ok {
+		return fromSlice(channels)
+	}
+	return fetchAll(s.Service.IterChannels(ctx, params), s.setChannels)
+}
+
+func (s *cachedService) ListChannels(ctx context.Context, params slack.ListChannelsParams) (*slack.PaginatedResult[slack.Channel], error) {
+	if !params.Pagination.All || !cachesChannels(params) {
+		return s.Service.ListChannels(ctx, params)
+	}
+	return collect(s.IterChannels(ctx, params))
+}
+
+// invalidateChannels drops the cached channels once a write has gone
+// through.
+func (s *cachedService) invalidateChannels(err error) error {
+	if err == nil {
+		_ = s.c.InvalidateChannels()
+	}
+	return err
+}
+
+func (s *cachedService) CreateChannel(ctx context.Context, name string, isPrivate bool) (*slack.Channel, error) {
+	ch, err := s.Service.CreateChannel(ctx, name, isPrivate)
+	return ch, s.invalidateChannels(err)
+}
+
+func (s *cachedService) ArchiveChannel(ctx context.Context, channelID string) error {
+	return s.invalidateChannels(s.Service.ArchiveChannel(ctx, channelID))
+}
+
+func (s *cachedService) UnarchiveChannel(ctx context.Context, channelID string) error {
+	return s.invalidateChannels(s.Service.UnarchiveChannel(ctx, channelID))
+}
+
+func (s *cachedService) JoinChannel(ctx context.Context, channelID string) (*slack.Channel, error) {
+	ch, err := s.Service.JoinChannel(ctx, channelID)
+	return ch, s.invalidateChannels(err)
+}
+
+func (s *cachedService) LeaveChannel(ctx context.Context, channelID string) error {
+	return s.invalidateChannels(s.Service.LeaveChannel(ctx, channelID))
+}
+
+func (s *cachedService) RenameChannel(ctx context.Context, channelID, name string) (*slack.Channel, error) {
+	ch, err := s.Service.RenameChannel(ctx, channelID, name)
+	return ch, s.invalidateChannels(err)
+}
+
+func (s *cachedService) ConvertChannel(ctx context.Context, channelID string, private bool) error {
+	return s.invalidateChannels(s.Service.ConvertChannel(ctx, channelID, private))
+}
+
+func (s *cachedService) InviteToChannel(ctx context.Context, channelID string, userIDs ...string) error {
+	return s.invalidateChannels(s.Service.InviteToChannel(ctx, channelID, userIDs...))
+}
+
+func (s *cachedService) KickFromChannel(ctx context.Context, channelID, userID string) error {
+	return s.invalidateChannels(s.Service.KickFromChannel(ctx, channelID, userID))
+}
+
+func (s *cachedService) SetChannelTopic(ctx context.Context, channelID, topic string) error {
+	return s.invalidateChannels(s.Service.SetChannelTopic(ctx, channelID, topic))
+}
+
+func (s *cachedService) SetChannelPurpose(ctx context.Context, channelID, purpose string) error {
+	return s.invalidateChannels(s.Service.SetChannelPurpose(ctx, channelID, purpose))
+}
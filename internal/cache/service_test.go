@@ -0,0 +1,209 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jackchuka/slackcli/internal/slack"
+	"github.com/jackchuka/slackcli/internal/slack/mocks"
+)
+
+func seqOf[T any](items []T, err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+		if err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+func drain[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func TestWrap_Users(t *testing.T) {
+	users := []slack.User{{ID: "U1", Name: "alice"}, {ID: "U2", Name: "bob"}}
+
+	t.Run("first listing is fetched and stored, later ones are not", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+		c := New(t.TempDir(), "xoxb-test", Options{})
+		svc := Wrap(mock, c)
+
+		mock.EXPECT().IterUsers(gomock.Any(), slack.PaginationParams{Limit: 200}).Return(seqOf(users, nil)).Times(1)
+
+		got, err := drain(svc.IterUsers(context.Background(), slack.PaginationParams{Limit: 200}))
+		require.NoError(t, err)
+		assert.Equal(t, users, got)
+
+		result, err := svc.ListUsers(context.Background(), slack.PaginationParams{All: true})
+		require.NoError(t, err)
+		assert.Equal(t, users, result.Items)
+
+		u, err := svc.GetUserInfo(context.Background(), "U2")
+		require.NoError(t, err)
+		assert.Equal(t, "bob", u.Name)
+	})
+
+	t.Run("failed listing is not stored", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+		c := New(t.TempDir(), "xoxb-test", Options{})
+		svc := Wrap(mock, c)
+
+		mock.EXPECT().IterUsers(gomock.Any(), gomock.Any()).Return(seqOf(users[:1], errors.New("ratelimited")))
+
+		_, err := drain(svc.IterUsers(context.Background(), slack.PaginationParams{}))
+		require.Error(t, err)
+		_, ok := c.Users()
+		assert.False(t, ok)
+	})
+
+	t.Run("unknown ID falls through to the API", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+		c := New(t.TempDir(), "xoxb-test", Options{})
+		require.NoError(t, c.SetUsers(users))
+		svc := Wrap(mock, c)
+
+		mock.EXPECT().GetUsers(gomock.Any(), []string{"U1", "U9"}).Return([]slack.User{{ID: "U1"}, {ID: "U9"}}, nil)
+
+		got, err := svc.GetUsers(context.Background(), []string{"U1", "U9"})
+		require.NoError(t, err)
+		assert.Len(t, got, 2)
+	})
+
+	t.Run("email lookups use the listing when it has addresses", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+		c := New(t.TempDir(), "xoxb-test", Options{})
+		require.NoError(t, c.SetUsers([]slack.User{{ID: "U1", Name: "alice", Email: "alice@example.com"}, {ID: "U2", Name: "bob"}}))
+		svc := Wrap(mock, c)
+
+		mock.EXPECT().LookupUserByEmail(gomock.Any(), "bob@example.com").Return(&slack.User{ID: "U2"}, nil)
+
+		u, err := svc.LookupUserByEmail(context.Background(), "Alice@Example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "U1", u.ID)
+
+		u, err = svc.LookupUserByEmail(context.Background(), "bob@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "U2", u.ID)
+	})
+
+	t.Run("stale entries are refetched", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+		c := New(t.TempDir(), "xoxb-test", Options{TTL: time.Minute})
+		require.NoError(t, c.SetUsers(users))
+		c.now = func() time.Time { return time.Now().Add(time.Hour) }
+		svc := Wrap(mock, c)
+
+		mock.EXPECT().GetUserInfo(gomock.Any(), "U1").Return(&slack.User{ID: "U1", Name: "alice2"}, nil)
+
+		u, err := svc.GetUserInfo(context.Background(), "U1")
+		require.NoError(t, err)
+		assert.Equal(t, "alice2", u.Name)
+	})
+}
+
+func TestWrap_Refresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockService(ctrl)
+	dir := t.TempDir()
+	require.NoError(t, New(dir, "xoxb-test", Options{}).SetUsers([]slack.User{{ID: "U1", Name: "old"}}))
+	c := New(dir, "xoxb-test", Options{Refresh: true})
+	svc := Wrap(mock, c)
+
+	fresh := []slack.User{{ID: "U1", Name: "new"}}
+	mock.EXPECT().IterUsers(gomock.Any(), gomock.Any()).Return(seqOf(fresh, nil)).Times(1)
+
+	got, err := drain(svc.IterUsers(context.Background(), slack.PaginationParams{}))
+	require.NoError(t, err)
+	assert.Equal(t, fresh, got)
+
+	// Once refetched, this run reads its own listing back.
+	u, err := svc.GetUserInfo(context.Background(), "U1")
+	require.NoError(t, err)
+	assert.Equal(t, "new", u.Name)
+	assert.Equal(t, 1, New(dir, "xoxb-test", Options{}).Status().Users)
+}
+
+func TestWrap_RefreshFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockService(ctrl)
+	dir := t.TempDir()
+	cached := []slack.User{{ID: "U1", Name: "old"}, {ID: "U2", Name: "bob"}}
+	require.NoError(t, New(dir, "xoxb-test", Options{}).SetUsers(cached))
+	svc := Wrap(mock, New(dir, "xoxb-test", Options{Refresh: true}))
+
+	mock.EXPECT().IterUsers(gomock.Any(), gomock.Any()).Return(seqOf(cached[:1], errors.New("ratelimited")))
+
+	_, err := drain(svc.IterUsers(context.Background(), slack.PaginationParams{}))
+	require.Error(t, err)
+
+	// A refetch that fails part way leaves the cached listing alone.
+	users, ok := New(dir, "xoxb-test", Options{}).Users()
+	require.True(t, ok)
+	assert.Equal(t, cached, users)
+}
+
+func TestWrap_Channels(t *testing.T) {
+	channels := []slack.Channel{{ID: "C1", Name: "general"}}
+
+	t.Run("only the default listing is cached", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+		svc := Wrap(mock, New(t.TempDir(), "xoxb-test", Options{}))
+
+		ims := slack.ListChannelsParams{Types: []string{"im"}}
+		mock.EXPECT().IterChannels(gomock.Any(), ims).Return(seqOf([]slack.Channel{{ID: "D1"}}, nil)).Times(2)
+		mock.EXPECT().IterChannels(gomock.Any(), slack.ListChannelsParams{}).Return(seqOf(channels, nil)).Times(1)
+
+		for range 2 {
+			_, err := drain(svc.IterChannels(context.Background(), ims))
+			require.NoError(t, err)
+			got, err := drain(svc.IterChannels(context.Background(), slack.ListChannelsParams{}))
+			require.NoError(t, err)
+			assert.Equal(t, channels, got)
+		}
+	})
+
+	t.Run("writes invalidate", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+		c := New(t.TempDir(), "xoxb-test", Options{})
+		require.NoError(t, c.SetChannels(channels))
+		svc := Wrap(mock, c)
+
+		mock.EXPECT().SetChannelTopic(gomock.Any(), "C1", "deploys").Return(errors.New("not_in_channel"))
+		require.Error(t, svc.SetChannelTopic(context.Background(), "C1", "deploys"))
+		_, ok := c.Channels()
+		assert.True(t, ok, "a failed write leaves the cache alone")
+
+		mock.EXPECT().CreateChannel(gomock.Any(), "deploys", false).Return(&slack.Channel{ID: "C2", Name: "deploys"}, nil)
+		_, err := svc.CreateChannel(context.Background(), "deploys", false)
+		require.NoError(t, err)
+		_, ok = c.Channels()
+		assert.False(t, ok)
+	})
+}
@@ -35,7 +35,7 @@ func newLoginCmd() *cobra.Command {
 
 			// Verify token
 			client := slack.NewClient(token)
-			authResult, err := client.AuthTest()
+			authResult, err := client.AuthTest(c.Context())
 			if err != nil {
 				return fmt.Errorf("authentication failed: %w", err)
 			}
@@ -109,7 +109,7 @@ func newStatusCmd() *cobra.Command {
 				})
 			}
 			client := slack.NewClient(token)
-			result, err := client.AuthTest()
+			result, err := client.AuthTest(c.Context())
 			if err != nil {
 				return rc.Formatter.Format(map[string]any{
 					"status": "error",
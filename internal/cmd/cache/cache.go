@@ -0,0 +1,79 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jackchuka/slackcli/internal/cmdutil"
+	"github.com/jackchuka/slackcli/internal/slack"
+)
+
+func NewCacheCmd() *cobra.Command {
+	cacheCmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the on-disk user and channel cache",
+	}
+	cacheCmd.AddCommand(newStatusCmd())
+	cacheCmd.AddCommand(newWarmCmd())
+	cacheCmd.AddCommand(newClearCmd())
+	return cacheCmd
+}
+
+func newStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show what the cache holds for the current workspace",
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			return rc.Formatter.Format(rc.Cache.Status())
+		},
+	}
+}
+
+func newWarmCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "warm",
+		Short: "Fetch every user and channel into the cache",
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			if rc.CacheOptions.Disabled {
+				return fmt.Errorf("cannot warm the cache with --no-cache")
+			}
+			// Read the listings to the end through a refreshing service,
+			// which replaces each cached listing only once it has fetched
+			// all of it, so a failed warm keeps the old entries.
+			opts := rc.CacheOptions
+			opts.Refresh = true
+			svc, warmed := cmdutil.NewService(rc.Resolver.Resolve(), opts, rc.ClientOptions...)
+			for _, err := range svc.IterUsers(c.Context(), slack.PaginationParams{Limit: 200}) {
+				if err != nil {
+					return err
+				}
+			}
+			for _, err := range svc.IterChannels(c.Context(), slack.ListChannelsParams{Pagination: slack.PaginationParams{Limit: 1000}}) {
+				if err != nil {
+					return err
+				}
+			}
+			return rc.Formatter.Format(warmed.Status())
+		},
+	}
+}
+
+func newClearCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "clear",
+		Short: "Delete the cache for the current workspace",
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			if err := rc.Cache.Clear(); err != nil {
+				return err
+			}
+			return rc.Formatter.Format(map[string]string{
+				"status": "cleared",
+				"path":   rc.Cache.Path(),
+			})
+		},
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/jackchuka/slackcli/internal/cmdutil"
+	"github.com/jackchuka/slackcli/internal/output"
 	"github.com/jackchuka/slackcli/internal/slack"
 )
 
@@ -16,16 +17,24 @@ func NewChannelsCmd() *cobra.Command {
 	}
 	channelsCmd.AddCommand(newListCmd())
 	channelsCmd.AddCommand(newInfoCmd())
+	channelsCmd.AddCommand(newMembersCmd())
 	channelsCmd.AddCommand(newCreateCmd())
 	channelsCmd.AddCommand(newArchiveCmd())
+	channelsCmd.AddCommand(newUnarchiveCmd())
+	channelsCmd.AddCommand(newJoinCmd())
+	channelsCmd.AddCommand(newLeaveCmd())
+	channelsCmd.AddCommand(newRenameCmd())
+	channelsCmd.AddCommand(newConvertCmd())
 	channelsCmd.AddCommand(newInviteCmd())
 	channelsCmd.AddCommand(newKickCmd())
 	channelsCmd.AddCommand(newTopicCmd())
 	channelsCmd.AddCommand(newPurposeCmd())
+	channelsCmd.AddCommand(newBookmarksCmd())
 	return channelsCmd
 }
 
 func newListCmd() *cobra.Command {
+	var types []string
 	var cursor string
 	var limit int
 	var all bool
@@ -35,17 +44,21 @@ func newListCmd() *cobra.Command {
 		Short: "List channels",
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			result, err := rc.Client.ListChannels(slack.PaginationParams{
-				Cursor: cursor,
-				Limit:  limit,
-				All:    all,
-			})
+			params := slack.ListChannelsParams{
+				Types:      types,
+				Pagination: slack.PaginationParams{Cursor: cursor, Limit: limit},
+			}
+			if all {
+				return output.Stream(rc.Formatter, rc.Client.IterChannels(c.Context(), params))
+			}
+			result, err := rc.Client.ListChannels(c.Context(), params)
 			if err != nil {
 				return err
 			}
 			return rc.Formatter.Format(result)
 		},
 	}
+	listCmd.Flags().StringSliceVar(&types, "types", nil, "Conversation types: public_channel,private_channel,mpim,im (default public_channel,private_channel)")
 	listCmd.Flags().StringVar(&cursor, "cursor", "", "Pagination cursor")
 	listCmd.Flags().IntVar(&limit, "limit", 100, "Number of channels per page")
 	listCmd.Flags().BoolVar(&all, "all", false, "Fetch all channels (auto-paginate)")
@@ -54,12 +67,12 @@ func newListCmd() *cobra.Command {
 
 func newInfoCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:   "info <channel-id>",
+		Use:   "info <channel>",
 		Short: "Get channel info",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			ch, err := rc.Client.GetChannelInfo(args[0])
+			ch, err := rc.Client.GetChannelInfo(c.Context(), args[0])
 			if err != nil {
 				return err
 			}
@@ -68,6 +81,50 @@ func newInfoCmd() *cobra.Command {
 	}
 }
 
+func newMembersCmd() *cobra.Command {
+	var cursor string
+	var limit int
+	var all bool
+	var resolve bool
+
+	membersCmd := &cobra.Command{
+		Use:   "members <channel>",
+		Short: "List channel members",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			params := slack.ListChannelMembersParams{
+				ChannelID:  args[0],
+				Pagination: slack.PaginationParams{Cursor: cursor, Limit: limit, All: all},
+			}
+			if all && !resolve {
+				return output.Stream(rc.Formatter, rc.Client.IterChannelMembers(c.Context(), params))
+			}
+			result, err := rc.Client.ListChannelMembers(c.Context(), params)
+			if err != nil {
+				return err
+			}
+			if !resolve {
+				return rc.Formatter.Format(result)
+			}
+			users, err := rc.Client.GetUsers(c.Context(), result.Items)
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(&slack.PaginatedResult[slack.User]{
+				Items:      users,
+				NextCursor: result.NextCursor,
+				HasMore:    result.HasMore,
+			})
+		},
+	}
+	membersCmd.Flags().StringVar(&cursor, "cursor", "", "Pagination cursor")
+	membersCmd.Flags().IntVar(&limit, "limit", 100, "Number of members per page")
+	membersCmd.Flags().BoolVar(&all, "all", false, "Fetch all members (auto-paginate)")
+	membersCmd.Flags().BoolVar(&resolve, "resolve", false, "Return full user records instead of IDs")
+	return membersCmd
+}
+
 func newCreateCmd() *cobra.Command {
 	var private bool
 
@@ -78,7 +135,7 @@ func newCreateCmd() *cobra.Command {
 		Annotations: map[string]string{"mode": "write"},
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			ch, err := rc.Client.CreateChannel(args[0], private)
+			ch, err := rc.Client.CreateChannel(c.Context(), args[0], private)
 			if err != nil {
 				return err
 			}
@@ -91,13 +148,13 @@ func newCreateCmd() *cobra.Command {
 
 func newArchiveCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:         "archive <channel-id>",
+		Use:         "archive <channel>",
 		Short:       "Archive a channel",
 		Args:        cobra.ExactArgs(1),
 		Annotations: map[string]string{"mode": "write"},
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			if err := rc.Client.ArchiveChannel(args[0]); err != nil {
+			if err := rc.Client.ArchiveChannel(c.Context(), args[0]); err != nil {
 				return err
 			}
 			return rc.Formatter.Format(map[string]string{
@@ -108,35 +165,146 @@ func newArchiveCmd() *cobra.Command {
 	}
 }
 
-func newInviteCmd() *cobra.Command {
+func newUnarchiveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "unarchive <channel>",
+		Short:       "Unarchive a channel",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			if err := rc.Client.UnarchiveChannel(c.Context(), args[0]); err != nil {
+				return err
+			}
+			return rc.Formatter.Format(map[string]string{
+				"status":  "unarchived",
+				"channel": args[0],
+			})
+		},
+	}
+}
+
+func newJoinCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:         "invite <channel-id> <user-id>...",
+		Use:         "join <channel>",
+		Short:       "Join a public channel",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			ch, err := rc.Client.JoinChannel(c.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(ch)
+		},
+	}
+}
+
+func newLeaveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "leave <channel>",
+		Short:       "Leave a channel",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			if err := rc.Client.LeaveChannel(c.Context(), args[0]); err != nil {
+				return err
+			}
+			return rc.Formatter.Format(map[string]string{
+				"status":  "left",
+				"channel": args[0],
+			})
+		},
+	}
+}
+
+func newRenameCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "rename <channel> <new-name>",
+		Short:       "Rename a channel",
+		Args:        cobra.ExactArgs(2),
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			ch, err := rc.Client.RenameChannel(c.Context(), args[0], args[1])
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(ch)
+		},
+	}
+}
+
+func newConvertCmd() *cobra.Command {
+	var to string
+
+	convertCmd := &cobra.Command{
+		Use:         "convert <channel>",
+		Short:       "Convert a channel between public and private (admin token required)",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			if to != "private" && to != "public" {
+				return fmt.Errorf("--to must be private or public, not %q", to)
+			}
+			if err := rc.Client.ConvertChannel(c.Context(), args[0], to == "private"); err != nil {
+				return err
+			}
+			return rc.Formatter.Format(map[string]string{
+				"status":  "converted",
+				"channel": args[0],
+				"to":      to,
+			})
+		},
+	}
+	convertCmd.Flags().StringVar(&to, "to", "", "Target visibility: private or public (required)")
+	_ = convertCmd.MarkFlagRequired("to")
+	return convertCmd
+}
+
+func newInviteCmd() *cobra.Command {
+	var userGroupID string
+
+	inviteCmd := &cobra.Command{
+		Use:         "invite <channel> [user]...",
 		Short:       "Invite users to a channel",
-		Args:        cobra.MinimumNArgs(2),
+		Args:        cobra.MinimumNArgs(1),
 		Annotations: map[string]string{"mode": "write"},
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			if err := rc.Client.InviteToChannel(args[0], args[1:]...); err != nil {
+			users, err := slack.ChannelInvitees(c.Context(), rc.Client, args[1:], userGroupID)
+			if err != nil {
+				return err
+			}
+			if len(users) == 0 {
+				return fmt.Errorf("at least one user or --usergroup is required")
+			}
+			if err := rc.Client.InviteToChannel(c.Context(), args[0], users...); err != nil {
 				return err
 			}
 			return rc.Formatter.Format(map[string]any{
 				"status":  "invited",
 				"channel": args[0],
-				"users":   args[1:],
+				"users":   users,
 			})
 		},
 	}
+	inviteCmd.Flags().StringVar(&userGroupID, "usergroup", "", "Also invite every member of this user group ID")
+	return inviteCmd
 }
 
 func newKickCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:         "kick <channel-id> <user-id>",
+		Use:         "kick <channel> <user>",
 		Short:       "Remove a user from a channel",
 		Args:        cobra.ExactArgs(2),
 		Annotations: map[string]string{"mode": "write"},
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			if err := rc.Client.KickFromChannel(args[0], args[1]); err != nil {
+			if err := rc.Client.KickFromChannel(c.Context(), args[0], args[1]); err != nil {
 				return err
 			}
 			return rc.Formatter.Format(map[string]string{
@@ -150,13 +318,13 @@ func newKickCmd() *cobra.Command {
 
 func newTopicCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:         "topic <channel-id> <topic>",
+		Use:         "topic <channel> <topic>",
 		Short:       "Set channel topic",
 		Args:        cobra.ExactArgs(2),
 		Annotations: map[string]string{"mode": "write"},
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			if err := rc.Client.SetChannelTopic(args[0], args[1]); err != nil {
+			if err := rc.Client.SetChannelTopic(c.Context(), args[0], args[1]); err != nil {
 				return err
 			}
 			return rc.Formatter.Format(map[string]string{
@@ -170,13 +338,13 @@ func newTopicCmd() *cobra.Command {
 
 func newPurposeCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:         "purpose <channel-id> <purpose>",
+		Use:         "purpose <channel> <purpose>",
 		Short:       "Set channel purpose",
 		Args:        cobra.ExactArgs(2),
 		Annotations: map[string]string{"mode": "write"},
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			if err := rc.Client.SetChannelPurpose(args[0], args[1]); err != nil {
+			if err := rc.Client.SetChannelPurpose(c.Context(), args[0], args[1]); err != nil {
 				return err
 			}
 			return rc.Formatter.Format(map[string]string{
@@ -188,6 +356,123 @@ func newPurposeCmd() *cobra.Command {
 	}
 }
 
+func newBookmarksCmd() *cobra.Command {
+	bookmarksCmd := &cobra.Command{
+		Use:   "bookmarks",
+		Short: "Manage channel bookmarks",
+	}
+	bookmarksCmd.AddCommand(newBookmarksListCmd())
+	bookmarksCmd.AddCommand(newBookmarksAddCmd())
+	bookmarksCmd.AddCommand(newBookmarksEditCmd())
+	bookmarksCmd.AddCommand(newBookmarksRemoveCmd())
+	return bookmarksCmd
+}
+
+func newBookmarksListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <channel>",
+		Short: "List a channel's bookmarks",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			bookmarks, err := rc.Client.ListBookmarks(c.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(bookmarks)
+		},
+	}
+}
+
+func newBookmarksAddCmd() *cobra.Command {
+	var title string
+	var link string
+	var emoji string
+
+	addCmd := &cobra.Command{
+		Use:         "add <channel>",
+		Short:       "Add a link bookmark to a channel",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			bookmark, err := rc.Client.AddBookmark(c.Context(), slack.AddBookmarkParams{
+				ChannelID: args[0],
+				Title:     title,
+				Link:      link,
+				Emoji:     emoji,
+			})
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(bookmark)
+		},
+	}
+	addCmd.Flags().StringVar(&title, "title", "", "Bookmark title (required)")
+	_ = addCmd.MarkFlagRequired("title")
+	addCmd.Flags().StringVar(&link, "link", "", "Bookmark URL (required)")
+	_ = addCmd.MarkFlagRequired("link")
+	addCmd.Flags().StringVar(&emoji, "emoji", "", "Emoji shown next to the bookmark, e.g. :books:")
+	return addCmd
+}
+
+func newBookmarksEditCmd() *cobra.Command {
+	var title string
+	var link string
+	var emoji string
+
+	editCmd := &cobra.Command{
+		Use:         "edit <channel> <bookmark-id>",
+		Short:       "Edit a channel bookmark",
+		Args:        cobra.ExactArgs(2),
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			params := slack.EditBookmarkParams{
+				ChannelID:  args[0],
+				BookmarkID: args[1],
+				Link:       link,
+			}
+			if c.Flags().Changed("title") {
+				params.Title = &title
+			}
+			if c.Flags().Changed("emoji") {
+				params.Emoji = &emoji
+			}
+			bookmark, err := rc.Client.EditBookmark(c.Context(), params)
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(bookmark)
+		},
+	}
+	editCmd.Flags().StringVar(&title, "title", "", "New title")
+	editCmd.Flags().StringVar(&link, "link", "", "New URL")
+	editCmd.Flags().StringVar(&emoji, "emoji", "", "New emoji; empty clears it")
+	editCmd.MarkFlagsOneRequired("title", "link", "emoji")
+	return editCmd
+}
+
+func newBookmarksRemoveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "remove <channel> <bookmark-id>",
+		Short:       "Remove a channel bookmark",
+		Args:        cobra.ExactArgs(2),
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			if err := rc.Client.RemoveBookmark(c.Context(), args[0], args[1]); err != nil {
+				return err
+			}
+			return rc.Formatter.Format(map[string]string{
+				"status":   "removed",
+				"channel":  args[0],
+				"bookmark": args[1],
+			})
+		},
+	}
+}
+
 func init() {
 	_ = fmt.Sprintf // avoid unused import error
 }
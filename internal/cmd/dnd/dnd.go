@@ -0,0 +1,77 @@
+package dnd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jackchuka/slackcli/internal/cmdutil"
+)
+
+func NewDNDCmd() *cobra.Command {
+	dndCmd := &cobra.Command{
+		Use:   "dnd",
+		Short: "Manage Do Not Disturb",
+	}
+	dndCmd.AddCommand(newInfoCmd())
+	dndCmd.AddCommand(newSnoozeCmd())
+	dndCmd.AddCommand(newEndCmd())
+	return dndCmd
+}
+
+func newInfoCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "info [user]",
+		Short: "Get Do Not Disturb status (defaults to you)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			userID := ""
+			if len(args) == 1 {
+				userID = args[0]
+			}
+			status, err := rc.Client.GetDNDInfo(c.Context(), userID)
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(status)
+		},
+	}
+}
+
+func newSnoozeCmd() *cobra.Command {
+	var duration time.Duration
+
+	snoozeCmd := &cobra.Command{
+		Use:         "snooze",
+		Short:       "Pause notifications for a while",
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			status, err := rc.Client.SetSnooze(c.Context(), duration)
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(status)
+		},
+	}
+	snoozeCmd.Flags().DurationVar(&duration, "duration", 0, "How long to snooze, e.g. 30m or 2h (required)")
+	_ = snoozeCmd.MarkFlagRequired("duration")
+	return snoozeCmd
+}
+
+func newEndCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "end",
+		Short:       "End the current snooze",
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			status, err := rc.Client.EndSnooze(c.Context())
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(status)
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package emoji
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/jackchuka/slackcli/internal/cmdutil"
+)
+
+func NewEmojiCmd() *cobra.Command {
+	emojiCmd := &cobra.Command{
+		Use:   "emoji",
+		Short: "Browse custom emoji",
+	}
+	emojiCmd.AddCommand(newListCmd())
+	return emojiCmd
+}
+
+func newListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List custom emoji, with aliases resolved",
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			emoji, err := rc.Client.ListEmoji(c.Context())
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(emoji)
+		},
+	}
+}
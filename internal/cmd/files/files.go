@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/jackchuka/slackcli/internal/cmdutil"
+	"github.com/jackchuka/slackcli/internal/output"
 	"github.com/jackchuka/slackcli/internal/slack"
 )
 
@@ -17,6 +18,7 @@ func NewFilesCmd() *cobra.Command {
 	}
 	filesCmd.AddCommand(newListCmd())
 	filesCmd.AddCommand(newInfoCmd())
+	filesCmd.AddCommand(newSearchCmd())
 	filesCmd.AddCommand(newUploadCmd())
 	filesCmd.AddCommand(newDownloadCmd())
 	filesCmd.AddCommand(newDeleteCmd())
@@ -35,19 +37,19 @@ func newListCmd() *cobra.Command {
 		Short: "List files",
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			result, err := rc.Client.ListFiles(slack.PaginationParams{
-				Cursor: cursor,
-				Limit:  limit,
-				All:    all,
-			}, channelID, userID)
+			params := slack.PaginationParams{Cursor: cursor, Limit: limit}
+			if all {
+				return output.Stream(rc.Formatter, rc.Client.IterFiles(c.Context(), params, channelID, userID))
+			}
+			result, err := rc.Client.ListFiles(c.Context(), params, channelID, userID)
 			if err != nil {
 				return err
 			}
 			return rc.Formatter.Format(result)
 		},
 	}
-	listCmd.Flags().StringVar(&channelID, "channel", "", "Filter by channel ID")
-	listCmd.Flags().StringVar(&userID, "user", "", "Filter by user ID")
+	listCmd.Flags().StringVar(&channelID, "channel", "", "Filter by channel ID or #name")
+	listCmd.Flags().StringVar(&userID, "user", "", "Filter by user ID, @handle or email")
 	listCmd.Flags().StringVar(&cursor, "cursor", "", "Pagination cursor")
 	listCmd.Flags().IntVar(&limit, "limit", 100, "Number of files per page")
 	listCmd.Flags().BoolVar(&all, "all", false, "Fetch all files (auto-paginate)")
@@ -61,7 +63,7 @@ func newInfoCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			file, err := rc.Client.GetFileInfo(args[0])
+			file, err := rc.Client.GetFileInfo(c.Context(), args[0])
 			if err != nil {
 				return err
 			}
@@ -70,6 +72,45 @@ func newInfoCmd() *cobra.Command {
 	}
 }
 
+func newSearchCmd() *cobra.Command {
+	var query string
+	var sort string
+	var sortDir string
+	var limit int
+	var page int
+	var all bool
+	var maxResults int
+
+	searchCmd := &cobra.Command{
+		Use:   "search",
+		Short: "Search files",
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			result, err := rc.Client.SearchFiles(c.Context(), slack.SearchParams{
+				Query:      query,
+				Sort:       sort,
+				SortDir:    sortDir,
+				Page:       page,
+				Pagination: slack.PaginationParams{Limit: limit, All: all},
+				MaxResults: maxResults,
+			})
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(result)
+		},
+	}
+	searchCmd.Flags().StringVar(&query, "query", "", "Search query (required)")
+	_ = searchCmd.MarkFlagRequired("query")
+	searchCmd.Flags().StringVar(&sort, "sort", "timestamp", "Sort field (timestamp|score)")
+	searchCmd.Flags().StringVar(&sortDir, "sort-dir", "desc", "Sort direction (asc|desc)")
+	searchCmd.Flags().IntVar(&limit, "limit", 20, "Number of results per page (max 100)")
+	searchCmd.Flags().IntVar(&page, "page", 1, "Page of results to fetch")
+	searchCmd.Flags().BoolVar(&all, "all", false, "Fetch every page from --page on, up to --max-results")
+	searchCmd.Flags().IntVar(&maxResults, "max-results", slack.DefaultSearchMaxResults, "Maximum results to fetch with --all")
+	return searchCmd
+}
+
 func newUploadCmd() *cobra.Command {
 	var channelID string
 	var title string
@@ -92,14 +133,14 @@ func newUploadCmd() *cobra.Command {
 				filename = args[0]
 			}
 
-			file, err := rc.Client.UploadFile(channelID, filename, title, f)
+			file, err := rc.Client.UploadFile(c.Context(), channelID, filename, title, f)
 			if err != nil {
 				return err
 			}
 			return rc.Formatter.Format(file)
 		},
 	}
-	uploadCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID (required)")
+	uploadCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID or #name (required)")
 	_ = uploadCmd.MarkFlagRequired("channel")
 	uploadCmd.Flags().StringVar(&filePath, "file", "", "File path (required)")
 	_ = uploadCmd.MarkFlagRequired("file")
@@ -116,7 +157,7 @@ func newDownloadCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			file, err := rc.Client.GetFileInfo(args[0])
+			file, err := rc.Client.GetFileInfo(c.Context(), args[0])
 			if err != nil {
 				return err
 			}
@@ -124,7 +165,7 @@ func newDownloadCmd() *cobra.Command {
 			if destPath == "" {
 				destPath = file.Name
 			}
-			if err := rc.Client.DownloadFile(file.URLPrivate, destPath); err != nil {
+			if err := rc.Client.DownloadFile(c.Context(), file.URLPrivate, destPath); err != nil {
 				return err
 			}
 			return rc.Formatter.Format(map[string]string{
@@ -146,7 +187,7 @@ func newDeleteCmd() *cobra.Command {
 		Annotations: map[string]string{"mode": "write"},
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			if err := rc.Client.DeleteFile(args[0]); err != nil {
+			if err := rc.Client.DeleteFile(c.Context(), args[0]); err != nil {
 				return err
 			}
 			return rc.Formatter.Format(map[string]string{
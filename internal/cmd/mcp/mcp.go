@@ -8,7 +8,6 @@ import (
 
 	"github.com/jackchuka/slackcli/internal/cmdutil"
 	mcpserver "github.com/jackchuka/slackcli/internal/mcp"
-	"github.com/jackchuka/slackcli/internal/slack"
 )
 
 func NewMCPCmd() *cobra.Command {
@@ -30,8 +29,8 @@ func newServeCmd() *cobra.Command {
 			if token == "" {
 				return fmt.Errorf("no token found. Set SLACK_TOKEN or run 'slackcli auth login'")
 			}
-			client := slack.NewClient(token)
-			s := mcpserver.NewServer(client, rc.ReadOnly)
+			client, _ := cmdutil.NewService(token, rc.CacheOptions, rc.ClientOptions...)
+			s := mcpserver.NewServer(client, rc.ReadOnly, rc.Timeout)
 			return server.ServeStdio(s)
 		},
 	}
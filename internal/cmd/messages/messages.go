@@ -1,9 +1,14 @@
 package messages
 
 import (
+	"encoding/json"
+	"fmt"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/jackchuka/slackcli/internal/cmdutil"
+	"github.com/jackchuka/slackcli/internal/output"
 	"github.com/jackchuka/slackcli/internal/slack"
 )
 
@@ -13,11 +18,16 @@ func NewMessagesCmd() *cobra.Command {
 		Short: "Manage messages",
 	}
 	messagesCmd.AddCommand(newListCmd())
+	messagesCmd.AddCommand(newGetCmd())
+	messagesCmd.AddCommand(newThreadCmd())
 	messagesCmd.AddCommand(newSendCmd())
 	messagesCmd.AddCommand(newReplyCmd())
+	messagesCmd.AddCommand(newEphemeralCmd())
 	messagesCmd.AddCommand(newEditCmd())
 	messagesCmd.AddCommand(newDeleteCmd())
 	messagesCmd.AddCommand(newSearchCmd())
+	messagesCmd.AddCommand(newScheduleCmd())
+	messagesCmd.AddCommand(newScheduledCmd())
 	return messagesCmd
 }
 
@@ -26,43 +36,180 @@ func newListCmd() *cobra.Command {
 	var cursor string
 	var limit int
 	var all bool
+	var permalinks bool
+	var resolve bool
 
 	listCmd := &cobra.Command{
 		Use:   "list",
 		Short: "List messages in a channel",
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			result, err := rc.Client.ListMessages(slack.ListMessagesParams{
+			params := slack.ListMessagesParams{
 				ChannelID:  channelID,
-				Pagination: slack.PaginationParams{Cursor: cursor, Limit: limit, All: all},
-			})
+				Pagination: slack.PaginationParams{Cursor: cursor, Limit: limit},
+				Permalinks: permalinks,
+			}
+			if all {
+				seq := rc.Client.IterMessages(c.Context(), params)
+				if resolve {
+					seq = slack.NewEnricher(rc.Client).Seq(c.Context(), seq)
+				}
+				return output.Stream(rc.Formatter, seq)
+			}
+			result, err := rc.Client.ListMessages(c.Context(), params)
 			if err != nil {
 				return err
 			}
+			if resolve {
+				slack.NewEnricher(rc.Client).Messages(c.Context(), result.Items)
+			}
 			return rc.Formatter.Format(result)
 		},
 	}
-	listCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID (required)")
+	listCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID or #name (required)")
 	_ = listCmd.MarkFlagRequired("channel")
 	listCmd.Flags().StringVar(&cursor, "cursor", "", "Pagination cursor")
 	listCmd.Flags().IntVar(&limit, "limit", 100, "Number of messages per page")
 	listCmd.Flags().BoolVar(&all, "all", false, "Fetch all messages")
+	listCmd.Flags().BoolVar(&permalinks, "permalinks", false, "Include each message's permalink (one extra API call per message)")
+	addResolveFlag(listCmd, &resolve)
 	return listCmd
 }
 
+func newGetCmd() *cobra.Command {
+	var permalink string
+	var channelID string
+	var timestamp string
+	var threadTS string
+
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "Show a single message, by permalink or by channel and timestamp",
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			if permalink != "" {
+				msg, err := rc.Client.GetMessageByPermalink(c.Context(), permalink)
+				if err != nil {
+					return err
+				}
+				return rc.Formatter.Format(msg)
+			}
+			if channelID == "" || timestamp == "" {
+				return fmt.Errorf("either --permalink or both --channel and --timestamp are required")
+			}
+			msg, err := rc.Client.GetMessage(c.Context(), slack.GetMessageParams{
+				ChannelID: channelID,
+				Timestamp: timestamp,
+				ThreadTS:  threadTS,
+			})
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(msg)
+		},
+	}
+	getCmd.Flags().StringVar(&permalink, "permalink", "", "Message link, e.g. https://acme.slack.com/archives/C123/p1700000000123456")
+	getCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID or #name")
+	getCmd.Flags().StringVar(&timestamp, "timestamp", "", "Message timestamp")
+	getCmd.Flags().StringVar(&threadTS, "thread-ts", "", "Parent timestamp, required for thread replies")
+	getCmd.MarkFlagsMutuallyExclusive("permalink", "channel")
+	getCmd.MarkFlagsMutuallyExclusive("permalink", "timestamp")
+	getCmd.MarkFlagsMutuallyExclusive("permalink", "thread-ts")
+	return getCmd
+}
+
+func newThreadCmd() *cobra.Command {
+	var channelID string
+	var threadTS string
+	var cursor string
+	var limit int
+	var all bool
+	var resolve bool
+	var oldest string
+	var latest string
+
+	threadCmd := &cobra.Command{
+		Use:   "thread",
+		Short: "Show a thread's parent message and replies",
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			params := slack.GetThreadParams{
+				ChannelID:  channelID,
+				ThreadTS:   threadTS,
+				Pagination: slack.PaginationParams{Cursor: cursor, Limit: limit},
+			}
+			var err error
+			if oldest != "" {
+				if params.Oldest, err = slack.ParseTimeBound(oldest, time.Now()); err != nil {
+					return err
+				}
+			}
+			if latest != "" {
+				if params.Latest, err = slack.ParseTimeBound(latest, time.Now()); err != nil {
+					return err
+				}
+			}
+			if all {
+				seq := rc.Client.IterThread(c.Context(), params)
+				if resolve {
+					seq = slack.NewEnricher(rc.Client).Seq(c.Context(), seq)
+				}
+				return output.Stream(rc.Formatter, seq)
+			}
+			result, err := rc.Client.GetThread(c.Context(), params)
+			if err != nil {
+				return err
+			}
+			if resolve {
+				slack.NewEnricher(rc.Client).Messages(c.Context(), result.Items)
+			}
+			return rc.Formatter.Format(result)
+		},
+	}
+	threadCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID or #name (required)")
+	_ = threadCmd.MarkFlagRequired("channel")
+	threadCmd.Flags().StringVar(&threadTS, "ts", "", "Timestamp of the thread's parent message (required)")
+	_ = threadCmd.MarkFlagRequired("ts")
+	threadCmd.Flags().StringVar(&cursor, "cursor", "", "Pagination cursor")
+	threadCmd.Flags().IntVar(&limit, "limit", 100, "Number of messages per page")
+	threadCmd.Flags().BoolVar(&all, "all", false, "Fetch the whole thread")
+	threadCmd.Flags().StringVar(&oldest, "oldest", "", "Only replies after this time: RFC 3339, a message timestamp, or a duration ago such as 2h or 7d")
+	threadCmd.Flags().StringVar(&latest, "latest", "", "Only replies before this time, in the same forms as --oldest")
+	addResolveFlag(threadCmd, &resolve)
+	return threadCmd
+}
+
 func newSendCmd() *cobra.Command {
 	var channelID string
+	var userIDs []string
 	var text string
+	var blocksArg string
+	var attachmentsArg string
+	var permalink bool
 
 	sendCmd := &cobra.Command{
 		Use:         "send",
-		Short:       "Send a message",
+		Short:       "Send a message to a channel, or directly to one or more users",
 		Annotations: map[string]string{"mode": "write"},
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			msg, err := rc.Client.SendMessage(slack.SendMessageParams{
-				ChannelID: channelID,
-				Text:      text,
+			blocks, attachments, err := readRichContent(c, blocksArg, attachmentsArg)
+			if err != nil {
+				return err
+			}
+			if len(userIDs) > 0 {
+				ch, err := rc.Client.OpenConversation(c.Context(), userIDs...)
+				if err != nil {
+					return err
+				}
+				channelID = ch.ID
+			}
+			msg, err := rc.Client.SendMessage(c.Context(), slack.SendMessageParams{
+				ChannelID:   channelID,
+				Text:        text,
+				Blocks:      blocks,
+				Attachments: attachments,
+				Permalink:   permalink,
 			})
 			if err != nil {
 				return err
@@ -70,17 +217,57 @@ func newSendCmd() *cobra.Command {
 			return rc.Formatter.Format(msg)
 		},
 	}
-	sendCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID (required)")
-	_ = sendCmd.MarkFlagRequired("channel")
-	sendCmd.Flags().StringVar(&text, "text", "", "Message text (required)")
-	_ = sendCmd.MarkFlagRequired("text")
+	sendCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID or #name")
+	sendCmd.Flags().StringSliceVar(&userIDs, "user", nil, "User ID, @handle or email to DM; repeat or comma-separate for a group DM")
+	sendCmd.MarkFlagsOneRequired("channel", "user")
+	sendCmd.MarkFlagsMutuallyExclusive("channel", "user")
+	addContentFlags(sendCmd, &text, &blocksArg, &attachmentsArg)
+	sendCmd.Flags().BoolVar(&permalink, "permalink", false, "Include the posted message's permalink")
 	return sendCmd
 }
 
+func addResolveFlag(cmd *cobra.Command, resolve *bool) {
+	cmd.Flags().BoolVar(resolve, "resolve", false, "Add user names and render mentions, links and entities as readable text (raw text kept in raw_text)")
+}
+
+// addContentFlags registers the message content flags, at least one of
+// which must be given.
+func addContentFlags(cmd *cobra.Command, text, blocks, attachments *string) {
+	cmd.Flags().StringVar(text, "text", "", "Message text; the notification fallback when --blocks is given")
+	cmd.Flags().StringVar(blocks, "blocks", "", "Block Kit JSON: inline, @file.json, or - for stdin")
+	cmd.Flags().StringVar(attachments, "attachments", "", "Legacy attachments JSON: inline, @file.json, or - for stdin")
+	cmd.MarkFlagsOneRequired("text", "blocks", "attachments")
+}
+
+// readRichContent reads the --blocks and --attachments values and checks the
+// blocks locally so mistakes are reported before anything is sent.
+func readRichContent(c *cobra.Command, blocksArg, attachmentsArg string) (json.RawMessage, json.RawMessage, error) {
+	if blocksArg == "-" && attachmentsArg == "-" {
+		return nil, nil, fmt.Errorf("--blocks and --attachments cannot both read from stdin")
+	}
+	blocks, err := cmdutil.ReadJSONArg(blocksArg, c.InOrStdin())
+	if err != nil {
+		return nil, nil, err
+	}
+	if blocks != nil {
+		if err := slack.ValidateBlocks(blocks); err != nil {
+			return nil, nil, err
+		}
+	}
+	attachments, err := cmdutil.ReadJSONArg(attachmentsArg, c.InOrStdin())
+	if err != nil {
+		return nil, nil, err
+	}
+	return blocks, attachments, nil
+}
+
 func newReplyCmd() *cobra.Command {
 	var channelID string
 	var threadTS string
 	var text string
+	var blocksArg string
+	var attachmentsArg string
+	var permalink bool
 
 	replyCmd := &cobra.Command{
 		Use:         "reply",
@@ -88,10 +275,17 @@ func newReplyCmd() *cobra.Command {
 		Annotations: map[string]string{"mode": "write"},
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			msg, err := rc.Client.SendMessage(slack.SendMessageParams{
-				ChannelID: channelID,
-				Text:      text,
-				ThreadTS:  threadTS,
+			blocks, attachments, err := readRichContent(c, blocksArg, attachmentsArg)
+			if err != nil {
+				return err
+			}
+			msg, err := rc.Client.SendMessage(c.Context(), slack.SendMessageParams{
+				ChannelID:   channelID,
+				Text:        text,
+				ThreadTS:    threadTS,
+				Blocks:      blocks,
+				Attachments: attachments,
+				Permalink:   permalink,
 			})
 			if err != nil {
 				return err
@@ -99,19 +293,62 @@ func newReplyCmd() *cobra.Command {
 			return rc.Formatter.Format(msg)
 		},
 	}
-	replyCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID (required)")
+	replyCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID or #name (required)")
 	_ = replyCmd.MarkFlagRequired("channel")
 	replyCmd.Flags().StringVar(&threadTS, "thread-ts", "", "Thread timestamp (required)")
 	_ = replyCmd.MarkFlagRequired("thread-ts")
-	replyCmd.Flags().StringVar(&text, "text", "", "Message text (required)")
-	_ = replyCmd.MarkFlagRequired("text")
+	addContentFlags(replyCmd, &text, &blocksArg, &attachmentsArg)
+	replyCmd.Flags().BoolVar(&permalink, "permalink", false, "Include the posted reply's permalink")
 	return replyCmd
 }
 
+func newEphemeralCmd() *cobra.Command {
+	var channelID string
+	var userID string
+	var threadTS string
+	var text string
+	var blocksArg string
+
+	ephemeralCmd := &cobra.Command{
+		Use:         "ephemeral",
+		Short:       "Send a message only one user in a channel can see",
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			blocks, _, err := readRichContent(c, blocksArg, "")
+			if err != nil {
+				return err
+			}
+			msg, err := rc.Client.PostEphemeral(c.Context(), slack.PostEphemeralParams{
+				ChannelID: channelID,
+				UserID:    userID,
+				Text:      text,
+				Blocks:    blocks,
+				ThreadTS:  threadTS,
+			})
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(msg)
+		},
+	}
+	ephemeralCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID or #name (required)")
+	_ = ephemeralCmd.MarkFlagRequired("channel")
+	ephemeralCmd.Flags().StringVar(&userID, "user", "", "User who will see the message: ID, @handle or email (required)")
+	_ = ephemeralCmd.MarkFlagRequired("user")
+	ephemeralCmd.Flags().StringVar(&threadTS, "thread-ts", "", "Thread timestamp to show the message in")
+	ephemeralCmd.Flags().StringVar(&text, "text", "", "Message text; the notification fallback when --blocks is given")
+	ephemeralCmd.Flags().StringVar(&blocksArg, "blocks", "", "Block Kit JSON: inline, @file.json, or - for stdin")
+	ephemeralCmd.MarkFlagsOneRequired("text", "blocks")
+	return ephemeralCmd
+}
+
 func newEditCmd() *cobra.Command {
 	var channelID string
 	var timestamp string
 	var text string
+	var blocksArg string
+	var attachmentsArg string
 
 	editCmd := &cobra.Command{
 		Use:         "edit",
@@ -119,19 +356,28 @@ func newEditCmd() *cobra.Command {
 		Annotations: map[string]string{"mode": "write"},
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			msg, err := rc.Client.EditMessage(channelID, timestamp, text)
+			blocks, attachments, err := readRichContent(c, blocksArg, attachmentsArg)
+			if err != nil {
+				return err
+			}
+			msg, err := rc.Client.EditMessage(c.Context(), slack.EditMessageParams{
+				ChannelID:   channelID,
+				Timestamp:   timestamp,
+				Text:        text,
+				Blocks:      blocks,
+				Attachments: attachments,
+			})
 			if err != nil {
 				return err
 			}
 			return rc.Formatter.Format(msg)
 		},
 	}
-	editCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID (required)")
+	editCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID or #name (required)")
 	_ = editCmd.MarkFlagRequired("channel")
 	editCmd.Flags().StringVar(&timestamp, "timestamp", "", "Message timestamp (required)")
 	_ = editCmd.MarkFlagRequired("timestamp")
-	editCmd.Flags().StringVar(&text, "text", "", "New message text (required)")
-	_ = editCmd.MarkFlagRequired("text")
+	addContentFlags(editCmd, &text, &blocksArg, &attachmentsArg)
 	return editCmd
 }
 
@@ -145,7 +391,7 @@ func newDeleteCmd() *cobra.Command {
 		Annotations: map[string]string{"mode": "write"},
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			if err := rc.Client.DeleteMessage(channelID, timestamp); err != nil {
+			if err := rc.Client.DeleteMessage(c.Context(), channelID, timestamp); err != nil {
 				return err
 			}
 			return rc.Formatter.Format(map[string]string{
@@ -155,7 +401,7 @@ func newDeleteCmd() *cobra.Command {
 			})
 		},
 	}
-	deleteCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID (required)")
+	deleteCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID or #name (required)")
 	_ = deleteCmd.MarkFlagRequired("channel")
 	deleteCmd.Flags().StringVar(&timestamp, "timestamp", "", "Message timestamp (required)")
 	_ = deleteCmd.MarkFlagRequired("timestamp")
@@ -167,21 +413,30 @@ func newSearchCmd() *cobra.Command {
 	var sort string
 	var sortDir string
 	var limit int
+	var page int
+	var all bool
+	var maxResults int
+	var resolve bool
 
 	searchCmd := &cobra.Command{
 		Use:   "search",
 		Short: "Search messages",
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			result, err := rc.Client.SearchMessages(slack.SearchParams{
+			result, err := rc.Client.SearchMessages(c.Context(), slack.SearchParams{
 				Query:      query,
 				Sort:       sort,
 				SortDir:    sortDir,
-				Pagination: slack.PaginationParams{Limit: limit},
+				Page:       page,
+				Pagination: slack.PaginationParams{Limit: limit, All: all},
+				MaxResults: maxResults,
 			})
 			if err != nil {
 				return err
 			}
+			if resolve {
+				slack.NewEnricher(rc.Client).Messages(c.Context(), result.Matches)
+			}
 			return rc.Formatter.Format(result)
 		},
 	}
@@ -189,6 +444,117 @@ func newSearchCmd() *cobra.Command {
 	_ = searchCmd.MarkFlagRequired("query")
 	searchCmd.Flags().StringVar(&sort, "sort", "timestamp", "Sort field (timestamp|score)")
 	searchCmd.Flags().StringVar(&sortDir, "sort-dir", "desc", "Sort direction (asc|desc)")
-	searchCmd.Flags().IntVar(&limit, "limit", 20, "Number of results")
+	searchCmd.Flags().IntVar(&limit, "limit", 20, "Number of results per page (max 100)")
+	searchCmd.Flags().IntVar(&page, "page", 1, "Page of results to fetch")
+	searchCmd.Flags().BoolVar(&all, "all", false, "Fetch every page from --page on, up to --max-results")
+	searchCmd.Flags().IntVar(&maxResults, "max-results", slack.DefaultSearchMaxResults, "Maximum results to fetch with --all")
+	addResolveFlag(searchCmd, &resolve)
 	return searchCmd
 }
+
+func newScheduleCmd() *cobra.Command {
+	var channelID string
+	var text string
+	var threadTS string
+	var at string
+
+	scheduleCmd := &cobra.Command{
+		Use:         "schedule",
+		Short:       "Schedule a message to be posted later",
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			postAt, err := slack.ParseTime(at, time.Now())
+			if err != nil {
+				return err
+			}
+			msg, err := rc.Client.ScheduleMessage(c.Context(), slack.ScheduleMessageParams{
+				ChannelID: channelID,
+				Text:      text,
+				ThreadTS:  threadTS,
+				PostAt:    postAt,
+			})
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(msg)
+		},
+	}
+	scheduleCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID or #name (required)")
+	_ = scheduleCmd.MarkFlagRequired("channel")
+	scheduleCmd.Flags().StringVar(&text, "text", "", "Message text (required)")
+	_ = scheduleCmd.MarkFlagRequired("text")
+	scheduleCmd.Flags().StringVar(&at, "at", "", "When to post: RFC 3339 time or relative duration like 30m, 2h, 1d (required)")
+	_ = scheduleCmd.MarkFlagRequired("at")
+	scheduleCmd.Flags().StringVar(&threadTS, "thread-ts", "", "Thread timestamp to reply to")
+	return scheduleCmd
+}
+
+func newScheduledCmd() *cobra.Command {
+	scheduledCmd := &cobra.Command{
+		Use:   "scheduled",
+		Short: "Manage scheduled messages",
+	}
+	scheduledCmd.AddCommand(newScheduledListCmd())
+	scheduledCmd.AddCommand(newScheduledCancelCmd())
+	return scheduledCmd
+}
+
+func newScheduledListCmd() *cobra.Command {
+	var channelID string
+	var cursor string
+	var limit int
+	var all bool
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List messages scheduled but not yet posted",
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			params := slack.ListScheduledMessagesParams{
+				ChannelID:  channelID,
+				Pagination: slack.PaginationParams{Cursor: cursor, Limit: limit},
+			}
+			if all {
+				return output.Stream(rc.Formatter, rc.Client.IterScheduledMessages(c.Context(), params))
+			}
+			result, err := rc.Client.ListScheduledMessages(c.Context(), params)
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(result)
+		},
+	}
+	listCmd.Flags().StringVar(&channelID, "channel", "", "Only list messages scheduled in this channel")
+	listCmd.Flags().StringVar(&cursor, "cursor", "", "Pagination cursor")
+	listCmd.Flags().IntVar(&limit, "limit", 100, "Number of messages per page")
+	listCmd.Flags().BoolVar(&all, "all", false, "Fetch all scheduled messages")
+	return listCmd
+}
+
+func newScheduledCancelCmd() *cobra.Command {
+	var channelID string
+	var id string
+
+	cancelCmd := &cobra.Command{
+		Use:         "cancel",
+		Short:       "Cancel a scheduled message",
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			if err := rc.Client.DeleteScheduledMessage(c.Context(), channelID, id); err != nil {
+				return err
+			}
+			return rc.Formatter.Format(map[string]string{
+				"status":  "cancelled",
+				"channel": channelID,
+				"id":      id,
+			})
+		},
+	}
+	cancelCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID or #name (required)")
+	_ = cancelCmd.MarkFlagRequired("channel")
+	cancelCmd.Flags().StringVar(&id, "id", "", "Scheduled message ID (required)")
+	_ = cancelCmd.MarkFlagRequired("id")
+	return cancelCmd
+}
@@ -0,0 +1,92 @@
+package pins
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/jackchuka/slackcli/internal/cmdutil"
+)
+
+func NewPinsCmd() *cobra.Command {
+	pinsCmd := &cobra.Command{
+		Use:   "pins",
+		Short: "Manage pinned messages",
+	}
+	pinsCmd.AddCommand(newAddCmd())
+	pinsCmd.AddCommand(newRemoveCmd())
+	pinsCmd.AddCommand(newListCmd())
+	return pinsCmd
+}
+
+func newAddCmd() *cobra.Command {
+	var channelID string
+	var timestamp string
+
+	addCmd := &cobra.Command{
+		Use:         "add",
+		Short:       "Pin a message to a channel",
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			if err := rc.Client.AddPin(c.Context(), channelID, timestamp); err != nil {
+				return err
+			}
+			return rc.Formatter.Format(map[string]string{
+				"status":    "pinned",
+				"channel":   channelID,
+				"timestamp": timestamp,
+			})
+		},
+	}
+	addCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID or #name (required)")
+	_ = addCmd.MarkFlagRequired("channel")
+	addCmd.Flags().StringVar(&timestamp, "timestamp", "", "Message timestamp (required)")
+	_ = addCmd.MarkFlagRequired("timestamp")
+	return addCmd
+}
+
+func newRemoveCmd() *cobra.Command {
+	var channelID string
+	var timestamp string
+
+	removeCmd := &cobra.Command{
+		Use:         "remove",
+		Short:       "Unpin a message from a channel",
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			if err := rc.Client.RemovePin(c.Context(), channelID, timestamp); err != nil {
+				return err
+			}
+			return rc.Formatter.Format(map[string]string{
+				"status":    "unpinned",
+				"channel":   channelID,
+				"timestamp": timestamp,
+			})
+		},
+	}
+	removeCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID or #name (required)")
+	_ = removeCmd.MarkFlagRequired("channel")
+	removeCmd.Flags().StringVar(&timestamp, "timestamp", "", "Message timestamp (required)")
+	_ = removeCmd.MarkFlagRequired("timestamp")
+	return removeCmd
+}
+
+func newListCmd() *cobra.Command {
+	var channelID string
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List items pinned to a channel",
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			items, err := rc.Client.ListPins(c.Context(), channelID)
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(items)
+		},
+	}
+	listCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID or #name (required)")
+	_ = listCmd.MarkFlagRequired("channel")
+	return listCmd
+}
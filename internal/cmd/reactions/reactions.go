@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/jackchuka/slackcli/internal/cmdutil"
+	"github.com/jackchuka/slackcli/internal/output"
 	"github.com/jackchuka/slackcli/internal/slack"
 )
 
@@ -29,7 +30,7 @@ func newAddCmd() *cobra.Command {
 		Annotations: map[string]string{"mode": "write"},
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			if err := rc.Client.AddReaction(channelID, timestamp, name); err != nil {
+			if err := rc.Client.AddReaction(c.Context(), channelID, timestamp, name); err != nil {
 				return err
 			}
 			return rc.Formatter.Format(map[string]string{
@@ -40,11 +41,11 @@ func newAddCmd() *cobra.Command {
 			})
 		},
 	}
-	addCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID (required)")
+	addCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID or #name (required)")
 	_ = addCmd.MarkFlagRequired("channel")
 	addCmd.Flags().StringVar(&timestamp, "timestamp", "", "Message timestamp (required)")
 	_ = addCmd.MarkFlagRequired("timestamp")
-	addCmd.Flags().StringVar(&name, "name", "", "Reaction emoji name (required)")
+	addCmd.Flags().StringVar(&name, "name", "", "Reaction emoji name, e.g. thumbsup or wave::skin-tone-2 (required)")
 	_ = addCmd.MarkFlagRequired("name")
 	return addCmd
 }
@@ -60,7 +61,7 @@ func newRemoveCmd() *cobra.Command {
 		Annotations: map[string]string{"mode": "write"},
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			if err := rc.Client.RemoveReaction(channelID, timestamp, name); err != nil {
+			if err := rc.Client.RemoveReaction(c.Context(), channelID, timestamp, name); err != nil {
 				return err
 			}
 			return rc.Formatter.Format(map[string]string{
@@ -71,11 +72,11 @@ func newRemoveCmd() *cobra.Command {
 			})
 		},
 	}
-	removeCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID (required)")
+	removeCmd.Flags().StringVar(&channelID, "channel", "", "Channel ID or #name (required)")
 	_ = removeCmd.MarkFlagRequired("channel")
 	removeCmd.Flags().StringVar(&timestamp, "timestamp", "", "Message timestamp (required)")
 	_ = removeCmd.MarkFlagRequired("timestamp")
-	removeCmd.Flags().StringVar(&name, "name", "", "Reaction emoji name (required)")
+	removeCmd.Flags().StringVar(&name, "name", "", "Reaction emoji name, e.g. thumbsup or wave::skin-tone-2 (required)")
 	_ = removeCmd.MarkFlagRequired("name")
 	return removeCmd
 }
@@ -90,17 +91,18 @@ func newListCmd() *cobra.Command {
 		Short: "List reactions for a user",
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			result, err := rc.Client.ListReactions(userID, slack.PaginationParams{
-				Limit: limit,
-				All:   all,
-			})
+			params := slack.PaginationParams{Limit: limit}
+			if all {
+				return output.Stream(rc.Formatter, rc.Client.IterReactions(c.Context(), userID, params))
+			}
+			result, err := rc.Client.ListReactions(c.Context(), userID, params)
 			if err != nil {
 				return err
 			}
 			return rc.Formatter.Format(result)
 		},
 	}
-	listCmd.Flags().StringVar(&userID, "user", "", "User ID (defaults to authenticated user)")
+	listCmd.Flags().StringVar(&userID, "user", "", "User ID, @handle or email (defaults to authenticated user)")
 	listCmd.Flags().IntVar(&limit, "limit", 100, "Number of reactions per page")
 	listCmd.Flags().BoolVar(&all, "all", false, "Fetch all reactions (auto-paginate)")
 	return listCmd
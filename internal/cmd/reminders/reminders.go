@@ -0,0 +1,120 @@
+package reminders
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/jackchuka/slackcli/internal/cmdutil"
+	"github.com/jackchuka/slackcli/internal/slack"
+)
+
+func NewRemindersCmd() *cobra.Command {
+	remindersCmd := &cobra.Command{
+		Use:   "reminders",
+		Short: "Manage reminders",
+	}
+	remindersCmd.AddCommand(newAddCmd())
+	remindersCmd.AddCommand(newListCmd())
+	remindersCmd.AddCommand(newInfoCmd())
+	remindersCmd.AddCommand(newCompleteCmd())
+	remindersCmd.AddCommand(newDeleteCmd())
+	return remindersCmd
+}
+
+func newAddCmd() *cobra.Command {
+	var text string
+	var when string
+	var userID string
+
+	addCmd := &cobra.Command{
+		Use:         "add",
+		Short:       "Create a reminder",
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			reminder, err := rc.Client.AddReminder(c.Context(), slack.AddReminderParams{
+				Text:   text,
+				Time:   when,
+				UserID: userID,
+			})
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(reminder)
+		},
+	}
+	addCmd.Flags().StringVar(&text, "text", "", "What to be reminded about (required)")
+	_ = addCmd.MarkFlagRequired("text")
+	addCmd.Flags().StringVar(&when, "time", "", `When: "in 2 hours", "every Monday at 9am", a Unix timestamp, or RFC 3339 (required)`)
+	_ = addCmd.MarkFlagRequired("time")
+	addCmd.Flags().StringVar(&userID, "user", "", "User ID, @handle or email to remind (defaults to authenticated user)")
+	return addCmd
+}
+
+func newListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List reminders created by or for you",
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			reminders, err := rc.Client.ListReminders(c.Context())
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(reminders)
+		},
+	}
+}
+
+func newInfoCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "info <reminder-id>",
+		Short: "Get reminder info",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			reminder, err := rc.Client.GetReminderInfo(c.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(reminder)
+		},
+	}
+}
+
+func newCompleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "complete <reminder-id>",
+		Short:       "Mark a reminder as complete",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			if err := rc.Client.CompleteReminder(c.Context(), args[0]); err != nil {
+				return err
+			}
+			return rc.Formatter.Format(map[string]string{
+				"status":   "completed",
+				"reminder": args[0],
+			})
+		},
+	}
+}
+
+func newDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "delete <reminder-id>",
+		Short:       "Delete a reminder",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			if err := rc.Client.DeleteReminder(c.Context(), args[0]); err != nil {
+				return err
+			}
+			return rc.Formatter.Format(map[string]string{
+				"status":   "deleted",
+				"reminder": args[0],
+			})
+		},
+	}
+}
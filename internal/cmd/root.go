@@ -1,23 +1,34 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/jackchuka/slackcli/internal/auth"
+	"github.com/jackchuka/slackcli/internal/cache"
 	"github.com/jackchuka/slackcli/internal/cmdutil"
 	"github.com/jackchuka/slackcli/internal/config"
 	"github.com/jackchuka/slackcli/internal/output"
 	"github.com/jackchuka/slackcli/internal/slack"
 
 	authcmd "github.com/jackchuka/slackcli/internal/cmd/auth"
+	cachecmd "github.com/jackchuka/slackcli/internal/cmd/cache"
 	channelscmd "github.com/jackchuka/slackcli/internal/cmd/channels"
+	dndcmd "github.com/jackchuka/slackcli/internal/cmd/dnd"
+	emojicmd "github.com/jackchuka/slackcli/internal/cmd/emoji"
 	filescmd "github.com/jackchuka/slackcli/internal/cmd/files"
 	mcpcmd "github.com/jackchuka/slackcli/internal/cmd/mcp"
 	messagescmd "github.com/jackchuka/slackcli/internal/cmd/messages"
+	pinscmd "github.com/jackchuka/slackcli/internal/cmd/pins"
 	reactionscmd "github.com/jackchuka/slackcli/internal/cmd/reactions"
+	reminderscmd "github.com/jackchuka/slackcli/internal/cmd/reminders"
+	searchcmd "github.com/jackchuka/slackcli/internal/cmd/search"
+	usergroupscmd "github.com/jackchuka/slackcli/internal/cmd/usergroups"
 	userscmd "github.com/jackchuka/slackcli/internal/cmd/users"
 )
 
@@ -26,6 +37,14 @@ var (
 	flagWorkspace string
 	flagOutput    string
 	flagReadOnly  bool
+	flagTimeout   time.Duration
+	flagNoCache   bool
+	flagRefresh   bool
+	flagCacheTTL  time.Duration
+	flagDebug     bool
+
+	// cancelTimeout releases the deadline installed by --timeout.
+	cancelTimeout context.CancelFunc = func() {}
 )
 
 func NewRootCmd() *cobra.Command {
@@ -61,14 +80,26 @@ func NewRootCmd() *cobra.Command {
 	rootCmd.PersistentFlags().StringVarP(&flagWorkspace, "workspace", "w", "", "Workspace name")
 	rootCmd.PersistentFlags().StringVarP(&flagOutput, "output", "o", "", "Output format (json|table)")
 	rootCmd.PersistentFlags().BoolVar(&flagReadOnly, "read-only", false, "Restrict to read-only operations (reject writes)")
+	rootCmd.PersistentFlags().DurationVar(&flagTimeout, "timeout", 0, "Timeout for Slack API calls, e.g. 30s (0 disables; applied per tool call in MCP mode)")
+	rootCmd.PersistentFlags().BoolVar(&flagNoCache, "no-cache", false, "Bypass the on-disk user and channel cache")
+	rootCmd.PersistentFlags().BoolVar(&flagRefresh, "refresh", false, "Refetch cached users and channels instead of reading them from disk")
+	rootCmd.PersistentFlags().DurationVar(&flagCacheTTL, "cache-ttl", cache.DefaultTTL, "How long cached users and channels stay fresh")
+	rootCmd.PersistentFlags().BoolVar(&flagDebug, "debug", false, "Log Slack API requests and responses to stderr")
 
 	rootCmd.AddCommand(NewVersionCmd())
 	rootCmd.AddCommand(authcmd.NewAuthCmd())
 	rootCmd.AddCommand(channelscmd.NewChannelsCmd())
 	rootCmd.AddCommand(messagescmd.NewMessagesCmd())
 	rootCmd.AddCommand(userscmd.NewUsersCmd())
+	rootCmd.AddCommand(usergroupscmd.NewUserGroupsCmd())
+	rootCmd.AddCommand(dndcmd.NewDNDCmd())
 	rootCmd.AddCommand(reactionscmd.NewReactionsCmd())
+	rootCmd.AddCommand(emojicmd.NewEmojiCmd())
+	rootCmd.AddCommand(pinscmd.NewPinsCmd())
+	rootCmd.AddCommand(reminderscmd.NewRemindersCmd())
 	rootCmd.AddCommand(filescmd.NewFilesCmd())
+	rootCmd.AddCommand(searchcmd.NewSearchCmd())
+	rootCmd.AddCommand(cachecmd.NewCacheCmd())
 	rootCmd.AddCommand(mcpcmd.NewMCPCmd())
 
 	return rootCmd
@@ -104,6 +135,15 @@ func initContext(cmd *cobra.Command, needsClient bool) error {
 		Writers:   writers,
 		Resolver:  resolver,
 		ReadOnly:  flagReadOnly,
+		Timeout:   flagTimeout,
+		CacheOptions: cache.Options{
+			TTL:      flagCacheTTL,
+			Disabled: flagNoCache,
+			Refresh:  flagRefresh,
+		},
+	}
+	if flagDebug {
+		rc.ClientOptions = append(rc.ClientOptions, slack.WithDebug())
 	}
 
 	if needsClient {
@@ -112,13 +152,22 @@ func initContext(cmd *cobra.Command, needsClient bool) error {
 			output.PrintError(writers, "no token found. Run 'slackcli auth login' or set SLACK_TOKEN")
 			os.Exit(2)
 		}
-		rc.Client = slack.NewClient(token)
+		rc.Client, rc.Cache = cmdutil.NewService(token, rc.CacheOptions, rc.ClientOptions...)
 	}
 
-	cmd.SetContext(cmdutil.SetRunContext(cmd.Context(), rc))
+	ctx := cmd.Context()
+	// The MCP server is long-running, so it applies the timeout to each
+	// tool call instead of to the whole process.
+	if flagTimeout > 0 && cmd.Name() != "serve" {
+		ctx, cancelTimeout = context.WithTimeout(ctx, flagTimeout)
+	}
+	cmd.SetContext(cmdutil.SetRunContext(ctx, rc))
 	return nil
 }
 
 func Execute() error {
-	return NewRootCmd().Execute()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	defer func() { cancelTimeout() }()
+	return NewRootCmd().ExecuteContext(ctx)
 }
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jackchuka/slackcli/internal/cmdutil"
+	"github.com/jackchuka/slackcli/internal/slack"
+)
+
+func TestDebugFlag(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok":true,"reminder":{"id":"Rm1","text":"stand up"}}`))
+	}))
+	t.Cleanup(srv.Close)
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	// The debug logger writes to the stderr it finds when a client is built.
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	stderr := os.Stderr
+	os.Stderr = w
+	t.Cleanup(func() { os.Stderr = stderr })
+
+	// probe builds a client from the run's options, as commands do, but
+	// aimed at the test server.
+	root := NewRootCmd()
+	root.AddCommand(&cobra.Command{
+		Use: "probe",
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			client := slack.NewClient("xoxb-test", append(rc.ClientOptions, slack.WithAPIURL(srv.URL+"/"))...)
+			_, err := client.GetReminderInfo(c.Context(), "Rm1")
+			return err
+		},
+	})
+	root.SetArgs([]string{"probe", "--debug", "--token", "xoxb-test", "--no-cache"})
+	err = root.ExecuteContext(context.Background())
+	os.Stderr = stderr
+	require.NoError(t, w.Close())
+	require.NoError(t, err)
+
+	logged, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Contains(t, string(logged), "reminders.info reminder=Rm1")
+	assert.Contains(t, string(logged), `"text":"stand up"`)
+	assert.NotContains(t, string(logged), "xoxb-test")
+}
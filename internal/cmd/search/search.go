@@ -0,0 +1,61 @@
+package search
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/jackchuka/slackcli/internal/cmdutil"
+	"github.com/jackchuka/slackcli/internal/slack"
+)
+
+func NewSearchCmd() *cobra.Command {
+	searchCmd := &cobra.Command{
+		Use:   "search",
+		Short: "Search messages and files together",
+	}
+	searchCmd.AddCommand(newAllCmd())
+	return searchCmd
+}
+
+func newAllCmd() *cobra.Command {
+	var query string
+	var sort string
+	var sortDir string
+	var limit int
+	var page int
+	var all bool
+	var maxResults int
+	var resolve bool
+
+	allCmd := &cobra.Command{
+		Use:   "all",
+		Short: "Search messages and files in one call",
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			result, err := rc.Client.SearchAll(c.Context(), slack.SearchParams{
+				Query:      query,
+				Sort:       sort,
+				SortDir:    sortDir,
+				Page:       page,
+				Pagination: slack.PaginationParams{Limit: limit, All: all},
+				MaxResults: maxResults,
+			})
+			if err != nil {
+				return err
+			}
+			if resolve {
+				slack.NewEnricher(rc.Client).Messages(c.Context(), result.Messages.Matches)
+			}
+			return rc.Formatter.Format(result)
+		},
+	}
+	allCmd.Flags().StringVar(&query, "query", "", "Search query (required)")
+	_ = allCmd.MarkFlagRequired("query")
+	allCmd.Flags().StringVar(&sort, "sort", "timestamp", "Sort field (timestamp|score)")
+	allCmd.Flags().StringVar(&sortDir, "sort-dir", "desc", "Sort direction (asc|desc)")
+	allCmd.Flags().IntVar(&limit, "limit", 20, "Number of results of each kind")
+	allCmd.Flags().IntVar(&page, "page", 1, "Page of results to fetch")
+	allCmd.Flags().BoolVar(&all, "all", false, "Fetch every page of each kind from --page on, up to --max-results")
+	allCmd.Flags().IntVar(&maxResults, "max-results", slack.DefaultSearchMaxResults, "Maximum results of each kind to fetch with --all")
+	allCmd.Flags().BoolVar(&resolve, "resolve", false, "Add user names to matched messages and render mentions, links and entities as readable text (raw text kept in raw_text)")
+	return allCmd
+}
@@ -0,0 +1,185 @@
+package usergroups
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/jackchuka/slackcli/internal/cmdutil"
+	"github.com/jackchuka/slackcli/internal/slack"
+)
+
+func NewUserGroupsCmd() *cobra.Command {
+	userGroupsCmd := &cobra.Command{
+		Use:   "usergroups",
+		Short: "Manage user groups",
+	}
+	userGroupsCmd.AddCommand(newListCmd())
+	userGroupsCmd.AddCommand(newMembersCmd())
+	userGroupsCmd.AddCommand(newCreateCmd())
+	userGroupsCmd.AddCommand(newUpdateCmd())
+	userGroupsCmd.AddCommand(newSetMembersCmd())
+	userGroupsCmd.AddCommand(newDisableCmd())
+	userGroupsCmd.AddCommand(newEnableCmd())
+	return userGroupsCmd
+}
+
+func newListCmd() *cobra.Command {
+	var includeDisabled bool
+	var includeUsers bool
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List user groups",
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			groups, err := rc.Client.ListUserGroups(c.Context(), slack.ListUserGroupsParams{
+				IncludeDisabled: includeDisabled,
+				IncludeUsers:    includeUsers,
+			})
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(groups)
+		},
+	}
+	listCmd.Flags().BoolVar(&includeDisabled, "include-disabled", false, "Include disabled user groups")
+	listCmd.Flags().BoolVar(&includeUsers, "include-users", false, "Include member user IDs")
+	return listCmd
+}
+
+func newMembersCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "members <usergroup-id>",
+		Short: "List the user IDs in a user group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			members, err := rc.Client.ListUserGroupMembers(c.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(members)
+		},
+	}
+}
+
+func newCreateCmd() *cobra.Command {
+	var handle string
+	var description string
+	var channels []string
+
+	createCmd := &cobra.Command{
+		Use:         "create <name>",
+		Short:       "Create a user group",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			group, err := rc.Client.CreateUserGroup(c.Context(), slack.CreateUserGroupParams{
+				Name:        args[0],
+				Handle:      handle,
+				Description: description,
+				Channels:    channels,
+			})
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(group)
+		},
+	}
+	createCmd.Flags().StringVar(&handle, "handle", "", "Mention handle, without the @")
+	createCmd.Flags().StringVar(&description, "description", "", "Description")
+	createCmd.Flags().StringSliceVar(&channels, "channels", nil, "Default channels for members, by ID or #name")
+	return createCmd
+}
+
+func newUpdateCmd() *cobra.Command {
+	var name string
+	var handle string
+	var description string
+	var channels []string
+
+	updateCmd := &cobra.Command{
+		Use:         "update <usergroup-id>",
+		Short:       "Update a user group's name, handle, description or channels",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			params := slack.UpdateUserGroupParams{
+				UserGroupID: args[0],
+				Name:        name,
+				Handle:      handle,
+			}
+			if c.Flags().Changed("description") {
+				params.Description = &description
+			}
+			if c.Flags().Changed("channels") {
+				params.Channels = channels
+				if params.Channels == nil {
+					params.Channels = []string{}
+				}
+			}
+			group, err := rc.Client.UpdateUserGroup(c.Context(), params)
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(group)
+		},
+	}
+	updateCmd.Flags().StringVar(&name, "name", "", "New name")
+	updateCmd.Flags().StringVar(&handle, "handle", "", "New mention handle, without the @")
+	updateCmd.Flags().StringVar(&description, "description", "", "New description")
+	updateCmd.Flags().StringSliceVar(&channels, "channels", nil, "New default channels for members, by ID or #name")
+	return updateCmd
+}
+
+func newSetMembersCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "set-members <usergroup-id> <user>...",
+		Short:       "Replace the members of a user group",
+		Args:        cobra.MinimumNArgs(2),
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			group, err := rc.Client.SetUserGroupMembers(c.Context(), args[0], args[1:])
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(group)
+		},
+	}
+}
+
+func newDisableCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "disable <usergroup-id>",
+		Short:       "Disable a user group",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			group, err := rc.Client.DisableUserGroup(c.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(group)
+		},
+	}
+}
+
+func newEnableCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "enable <usergroup-id>",
+		Short:       "Re-enable a disabled user group",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			group, err := rc.Client.EnableUserGroup(c.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(group)
+		},
+	}
+}
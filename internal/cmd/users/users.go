@@ -1,9 +1,12 @@
 package users
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/jackchuka/slackcli/internal/cmdutil"
+	"github.com/jackchuka/slackcli/internal/output"
 	"github.com/jackchuka/slackcli/internal/slack"
 )
 
@@ -14,11 +17,15 @@ func NewUsersCmd() *cobra.Command {
 	}
 	usersCmd.AddCommand(newListCmd())
 	usersCmd.AddCommand(newInfoCmd())
+	usersCmd.AddCommand(newFindCmd())
 	usersCmd.AddCommand(newPresenceCmd())
+	usersCmd.AddCommand(newSetPresenceCmd())
+	usersCmd.AddCommand(newStatusCmd())
 	return usersCmd
 }
 
 func newListCmd() *cobra.Command {
+	var cursor string
 	var limit int
 	var all bool
 
@@ -27,16 +34,18 @@ func newListCmd() *cobra.Command {
 		Short: "List users",
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			result, err := rc.Client.ListUsers(slack.PaginationParams{
-				Limit: limit,
-				All:   all,
-			})
+			params := slack.PaginationParams{Cursor: cursor, Limit: limit}
+			if all {
+				return output.Stream(rc.Formatter, rc.Client.IterUsers(c.Context(), params))
+			}
+			result, err := rc.Client.ListUsers(c.Context(), params)
 			if err != nil {
 				return err
 			}
 			return rc.Formatter.Format(result)
 		},
 	}
+	listCmd.Flags().StringVar(&cursor, "cursor", "", "Pagination cursor")
 	listCmd.Flags().IntVar(&limit, "limit", 100, "Number of users per page")
 	listCmd.Flags().BoolVar(&all, "all", false, "Fetch all users")
 	return listCmd
@@ -44,12 +53,12 @@ func newListCmd() *cobra.Command {
 
 func newInfoCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:   "info <user-id>",
+		Use:   "info <user>",
 		Short: "Get user info",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			user, err := rc.Client.GetUserInfo(args[0])
+			user, err := rc.Client.GetUserInfo(c.Context(), args[0])
 			if err != nil {
 				return err
 			}
@@ -58,14 +67,40 @@ func newInfoCmd() *cobra.Command {
 	}
 }
 
+func newFindCmd() *cobra.Command {
+	var limit int
+	var includeDeleted bool
+
+	findCmd := &cobra.Command{
+		Use:   "find <query>",
+		Short: "Find users by handle, name, email or title",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			users, err := slack.FindUsers(c.Context(), rc.Client, slack.FindUsersParams{
+				Query:          args[0],
+				Limit:          limit,
+				IncludeDeleted: includeDeleted,
+			})
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(users)
+		},
+	}
+	findCmd.Flags().IntVar(&limit, "limit", slack.DefaultFindUsersLimit, "Maximum number of users to return")
+	findCmd.Flags().BoolVar(&includeDeleted, "include-deleted", false, "Include deactivated users")
+	return findCmd
+}
+
 func newPresenceCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:   "presence <user-id>",
+		Use:   "presence <user>",
 		Short: "Get user presence",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(c *cobra.Command, args []string) error {
 			rc := cmdutil.GetRunContext(c.Context())
-			presence, err := rc.Client.GetUserPresence(args[0])
+			presence, err := rc.Client.GetUserPresence(c.Context(), args[0])
 			if err != nil {
 				return err
 			}
@@ -76,3 +111,81 @@ func newPresenceCmd() *cobra.Command {
 		},
 	}
 }
+
+func newSetPresenceCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "set-presence <auto|away>",
+		Short:       "Set your presence",
+		Args:        cobra.ExactArgs(1),
+		ValidArgs:   []string{"auto", "away"},
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			if err := rc.Client.SetPresence(c.Context(), args[0]); err != nil {
+				return err
+			}
+			return rc.Formatter.Format(map[string]string{
+				"status":   "updated",
+				"presence": args[0],
+			})
+		},
+	}
+}
+
+func newStatusCmd() *cobra.Command {
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Manage your custom status",
+	}
+	statusCmd.AddCommand(newStatusSetCmd())
+	statusCmd.AddCommand(newStatusClearCmd())
+	return statusCmd
+}
+
+func newStatusSetCmd() *cobra.Command {
+	var text string
+	var emoji string
+	var expires string
+
+	setCmd := &cobra.Command{
+		Use:         "set",
+		Short:       "Set your custom status",
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			params := slack.SetStatusParams{Text: text, Emoji: emoji}
+			if expires != "" {
+				t, err := slack.ParseTime(expires, time.Now())
+				if err != nil {
+					return err
+				}
+				params.Expiration = t
+			}
+			status, err := rc.Client.SetStatus(c.Context(), params)
+			if err != nil {
+				return err
+			}
+			return rc.Formatter.Format(status)
+		},
+	}
+	setCmd.Flags().StringVar(&text, "text", "", "Status text (required)")
+	_ = setCmd.MarkFlagRequired("text")
+	setCmd.Flags().StringVar(&emoji, "emoji", "", "Status emoji name, e.g. rocket")
+	setCmd.Flags().StringVar(&expires, "expires", "", "When the status clears: RFC 3339 or relative like 30m, 2h or 1d (default never)")
+	return setCmd
+}
+
+func newStatusClearCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "clear",
+		Short:       "Clear your custom status",
+		Annotations: map[string]string{"mode": "write"},
+		RunE: func(c *cobra.Command, args []string) error {
+			rc := cmdutil.GetRunContext(c.Context())
+			if _, err := rc.Client.SetStatus(c.Context(), slack.SetStatusParams{}); err != nil {
+				return err
+			}
+			return rc.Formatter.Format(map[string]string{"status": "cleared"})
+		},
+	}
+}
@@ -2,8 +2,10 @@ package cmdutil
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackchuka/slackcli/internal/auth"
+	"github.com/jackchuka/slackcli/internal/cache"
 	"github.com/jackchuka/slackcli/internal/config"
 	"github.com/jackchuka/slackcli/internal/output"
 	"github.com/jackchuka/slackcli/internal/slack"
@@ -14,12 +16,16 @@ type contextKey string
 const runContextKey contextKey = "run_context"
 
 type RunContext struct {
-	Config    *config.Config
-	Client    slack.Service
-	Formatter output.Formatter
-	Writers   *output.Writers
-	Resolver  *auth.Resolver
-	ReadOnly  bool
+	Config        *config.Config
+	Client        slack.Service
+	Cache         *cache.Cache
+	CacheOptions  cache.Options
+	ClientOptions []slack.Option
+	Formatter     output.Formatter
+	Writers       *output.Writers
+	Resolver      *auth.Resolver
+	ReadOnly      bool
+	Timeout       time.Duration
 }
 
 func GetRunContext(ctx context.Context) *RunContext {
@@ -29,3 +35,16 @@ func GetRunContext(ctx context.Context) *RunContext {
 func SetRunContext(ctx context.Context, rc *RunContext) context.Context {
 	return context.WithValue(ctx, runContextKey, rc)
 }
+
+// NewService builds the Slack service commands and MCP tools use: the API
+// client behind the metadata cache, unless opts disables it, behind name
+// resolution. The cache is returned even when disabled so it can still be
+// inspected and cleared.
+func NewService(token string, opts cache.Options, clientOpts ...slack.Option) (slack.Service, *cache.Cache) {
+	c := cache.New(cache.DefaultDir(), token, opts)
+	var svc slack.Service = slack.NewClient(token, clientOpts...)
+	if !opts.Disabled {
+		svc = cache.Wrap(svc, c)
+	}
+	return slack.WithNameResolution(svc), c
+}
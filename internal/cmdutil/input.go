@@ -0,0 +1,45 @@
+package cmdutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ReadJSONArg resolves a flag value holding JSON: "@path" reads the file at
+// path, "-" reads stdin, and anything else is taken as literal JSON. An empty
+// value yields nil.
+func ReadJSONArg(value string, stdin io.Reader) (json.RawMessage, error) {
+	var data []byte
+	var err error
+	switch {
+	case value == "":
+		return nil, nil
+	case value == "-":
+		data, err = io.ReadAll(stdin)
+	case strings.HasPrefix(value, "@"):
+		data, err = os.ReadFile(strings.TrimPrefix(value, "@"))
+	default:
+		data = []byte(value)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("invalid JSON in %s", describeJSONArg(value))
+	}
+	return json.RawMessage(data), nil
+}
+
+func describeJSONArg(value string) string {
+	switch {
+	case value == "-":
+		return "stdin"
+	case strings.HasPrefix(value, "@"):
+		return strings.TrimPrefix(value, "@")
+	default:
+		return "argument"
+	}
+}
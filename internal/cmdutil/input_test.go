@@ -0,0 +1,48 @@
+package cmdutil
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadJSONArg(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "blocks.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[{"type":"divider"}]`), 0o600))
+
+	tests := []struct {
+		name  string
+		value string
+		stdin string
+		want  string
+	}{
+		{"empty", "", "", ""},
+		{"literal", `[{"type":"divider"}]`, "", `[{"type":"divider"}]`},
+		{"file", "@" + path, "", `[{"type":"divider"}]`},
+		{"stdin", "-", `{"blocks":[]}`, `{"blocks":[]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadJSONArg(tt.value, strings.NewReader(tt.stdin))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestReadJSONArg_Errors(t *testing.T) {
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, err := ReadJSONArg("-", strings.NewReader("{not json"))
+		assert.ErrorContains(t, err, "invalid JSON in stdin")
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := ReadJSONArg("@"+filepath.Join(t.TempDir(), "missing.json"), nil)
+		assert.Error(t, err)
+	})
+}
@@ -1,24 +1,36 @@
 package mcp
 
 import (
+	"context"
+	"time"
+
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 
 	"github.com/jackchuka/slackcli/internal/slack"
 )
 
-func NewServer(client slack.Service, readOnly bool) *server.MCPServer {
-	s := server.NewMCPServer(
-		"slackcli",
-		"1.0.0",
+// NewServer builds the MCP server. A positive timeout bounds each tool call.
+func NewServer(client slack.Service, readOnly bool, timeout time.Duration) *server.MCPServer {
+	opts := []server.ServerOption{
 		server.WithToolCapabilities(true),
 		server.WithRecovery(),
-	)
+	}
+	if timeout > 0 {
+		opts = append(opts, server.WithToolHandlerMiddleware(withTimeout(timeout)))
+	}
+	s := server.NewMCPServer("slackcli", "1.0.0", opts...)
 
 	registerChannelTools(s, client, readOnly)
+	registerBookmarkTools(s, client, readOnly)
 	registerMessageTools(s, client, readOnly)
 	registerUserTools(s, client)
+	registerUserGroupTools(s, client, readOnly)
+	registerStatusTools(s, client, readOnly)
 	registerReactionTools(s, client, readOnly)
+	registerEmojiTools(s, client)
+	registerPinTools(s, client, readOnly)
+	registerReminderTools(s, client, readOnly)
 	registerFileTools(s, client, readOnly)
 	registerSearchTools(s, client)
 	registerAuthTools(s, client)
@@ -29,3 +41,13 @@ func NewServer(client slack.Service, readOnly bool) *server.MCPServer {
 func errResult(err error) *mcp.CallToolResult {
 	return mcp.NewToolResultError(err.Error())
 }
+
+func withTimeout(timeout time.Duration) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			return next(ctx, request)
+		}
+	}
+}
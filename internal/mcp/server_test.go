@@ -1,10 +1,13 @@
 package mcp
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/jackchuka/slackcli/internal/slack/mocks"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -13,17 +16,31 @@ import (
 func TestNewServer_ReadWrite(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mock := mocks.NewMockService(ctrl)
-	s := NewServer(mock, false)
+	s := NewServer(mock, false, 0)
 	require.NotNil(t, s)
 }
 
 func TestNewServer_ReadOnly(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mock := mocks.NewMockService(ctrl)
-	s := NewServer(mock, true)
+	s := NewServer(mock, true, 0)
 	require.NotNil(t, s)
 }
 
+func TestWithTimeout(t *testing.T) {
+	handler := withTimeout(time.Minute)(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 5*time.Second)
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	result, err := handler(context.Background(), newRequest(nil))
+
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+}
+
 func TestToJSON(t *testing.T) {
 	t.Run("struct", func(t *testing.T) {
 		type data struct {
@@ -17,7 +17,7 @@ func registerAuthTools(s *server.MCPServer, client slack.Service) {
 
 func makeAuthTest(client slack.Service) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		result, err := client.AuthTest()
+		result, err := client.AuthTest(ctx)
 		if err != nil {
 			return errResult(err), nil
 		}
@@ -16,7 +16,7 @@ func TestMakeAuthTest(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().AuthTest().Return(&slack.AuthTestResult{
+		mock.EXPECT().AuthTest(gomock.Any()).Return(&slack.AuthTestResult{
 			UserID: "U123",
 			User:   "alice",
 			TeamID: "T456",
@@ -35,7 +35,7 @@ func TestMakeAuthTest(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().AuthTest().Return(nil, &slack.SlackError{
+		mock.EXPECT().AuthTest(gomock.Any()).Return(nil, &slack.SlackError{
 			Code: slack.ErrAuth, Message: "invalid_auth",
 		})
 
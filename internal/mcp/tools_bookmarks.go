@@ -0,0 +1,135 @@
+package mcp
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/jackchuka/slackcli/internal/slack"
+)
+
+func registerBookmarkTools(s *server.MCPServer, client slack.Service, readOnly bool) {
+	s.AddTool(mcp.NewTool("list_bookmarks",
+		mcp.WithDescription("List the bookmarks (links to dashboards, docs, etc.) in a channel's bookmark bar"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+	), makeListBookmarks(client))
+
+	if readOnly {
+		return
+	}
+
+	s.AddTool(mcp.NewTool("add_bookmark",
+		mcp.WithDescription("Add a link bookmark to a channel"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+		mcp.WithString("title", mcp.Required(), mcp.Description("Bookmark title")),
+		mcp.WithString("link", mcp.Required(), mcp.Description("Bookmark URL")),
+		mcp.WithString("emoji", mcp.Description("Emoji shown next to the bookmark, e.g. :books:")),
+	), makeAddBookmark(client))
+
+	s.AddTool(mcp.NewTool("edit_bookmark",
+		mcp.WithDescription("Edit a channel bookmark; omitted fields are left unchanged"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+		mcp.WithString("bookmark_id", mcp.Required(), mcp.Description("Bookmark ID")),
+		mcp.WithString("title", mcp.Description("New title")),
+		mcp.WithString("link", mcp.Description("New URL")),
+		mcp.WithString("emoji", mcp.Description("New emoji; empty string clears it")),
+	), makeEditBookmark(client))
+
+	s.AddTool(mcp.NewTool("remove_bookmark",
+		mcp.WithDescription("Remove a channel bookmark"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+		mcp.WithString("bookmark_id", mcp.Required(), mcp.Description("Bookmark ID")),
+	), makeRemoveBookmark(client))
+}
+
+func makeListBookmarks(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		bookmarks, err := client.ListBookmarks(ctx, channelID)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(bookmarks)), nil
+	}
+}
+
+func makeAddBookmark(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		title, err := request.RequireString("title")
+		if err != nil {
+			return errResult(err), nil
+		}
+		link, err := request.RequireString("link")
+		if err != nil {
+			return errResult(err), nil
+		}
+		emoji := request.GetString("emoji", "")
+
+		bookmark, err := client.AddBookmark(ctx, slack.AddBookmarkParams{
+			ChannelID: channelID,
+			Title:     title,
+			Link:      link,
+			Emoji:     emoji,
+		})
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(bookmark)), nil
+	}
+}
+
+func makeEditBookmark(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		bookmarkID, err := request.RequireString("bookmark_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		params := slack.EditBookmarkParams{
+			ChannelID:  channelID,
+			BookmarkID: bookmarkID,
+			Link:       request.GetString("link", ""),
+		}
+		args := request.GetArguments()
+		if title, ok := args["title"].(string); ok {
+			params.Title = &title
+		}
+		if emoji, ok := args["emoji"].(string); ok {
+			params.Emoji = &emoji
+		}
+
+		bookmark, err := client.EditBookmark(ctx, params)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(bookmark)), nil
+	}
+}
+
+func makeRemoveBookmark(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		bookmarkID, err := request.RequireString("bookmark_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		if err := client.RemoveBookmark(ctx, channelID, bookmarkID); err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(map[string]string{"status": "removed"})), nil
+	}
+}
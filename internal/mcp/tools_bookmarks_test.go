@@ -0,0 +1,113 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackchuka/slackcli/internal/slack"
+	"github.com/jackchuka/slackcli/internal/slack/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMakeListBookmarks(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListBookmarks(gomock.Any(), "C123").Return([]slack.Bookmark{
+			{ID: "Bk1", ChannelID: "C123", Title: "Dashboard", Link: "https://grafana.example.com", Type: "link"},
+		}, nil)
+
+		handler := makeListBookmarks(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListBookmarks(gomock.Any(), "C999").Return(nil, &slack.SlackError{
+			Code: slack.ErrNotFound, Message: "channel_not_found",
+		})
+
+		handler := makeListBookmarks(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C999",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestMakeAddBookmark(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().AddBookmark(gomock.Any(), slack.AddBookmarkParams{
+			ChannelID: "C123",
+			Title:     "Runbook",
+			Link:      "https://docs.example.com/runbook",
+		}).Return(&slack.Bookmark{ID: "Bk1", ChannelID: "C123", Title: "Runbook"}, nil)
+
+		handler := makeAddBookmark(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"title":      "Runbook",
+			"link":       "https://docs.example.com/runbook",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestMakeEditBookmark(t *testing.T) {
+	t.Run("only given fields change", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		emoji := ""
+		mock.EXPECT().EditBookmark(gomock.Any(), slack.EditBookmarkParams{
+			ChannelID:  "C123",
+			BookmarkID: "Bk1",
+			Emoji:      &emoji,
+		}).Return(&slack.Bookmark{ID: "Bk1", ChannelID: "C123", Title: "Runbook"}, nil)
+
+		handler := makeEditBookmark(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id":  "C123",
+			"bookmark_id": "Bk1",
+			"emoji":       "",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestMakeRemoveBookmark(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().RemoveBookmark(gomock.Any(), "C123", "Bk1").Return(nil)
+
+		handler := makeRemoveBookmark(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id":  "C123",
+			"bookmark_id": "Bk1",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
@@ -18,11 +18,28 @@ func registerChannelTools(s *server.MCPServer, client slack.Service, readOnly bo
 		mcp.WithString("cursor", mcp.Description("Pagination cursor")),
 	), makeListChannels(client))
 
+	s.AddTool(mcp.NewTool("list_conversations",
+		mcp.WithDescription("List Slack conversations of the given types, including direct messages (im) and group direct messages (mpim)"),
+		mcp.WithArray("types", mcp.Description("Conversation types: public_channel, private_channel, mpim, im (default: all)"), mcp.WithStringItems()),
+		mcp.WithNumber("limit", mcp.Description("Max conversations to return"), mcp.DefaultNumber(100)),
+		mcp.WithBoolean("all", mcp.Description("Fetch all conversations (auto-paginate)")),
+		mcp.WithString("cursor", mcp.Description("Pagination cursor")),
+	), makeListConversations(client))
+
 	s.AddTool(mcp.NewTool("get_channel_info",
 		mcp.WithDescription("Get information about a Slack channel"),
-		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID")),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
 	), makeGetChannelInfo(client))
 
+	s.AddTool(mcp.NewTool("list_channel_members",
+		mcp.WithDescription("List the members of a channel"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+		mcp.WithBoolean("resolve", mcp.Description("Return full user records instead of IDs")),
+		mcp.WithString("cursor", mcp.Description("Pagination cursor")),
+		mcp.WithNumber("limit", mcp.Description("Max members to return"), mcp.DefaultNumber(100)),
+		mcp.WithBoolean("all", mcp.Description("Fetch all members")),
+	), makeListChannelMembers(client))
+
 	if readOnly {
 		return
 	}
@@ -35,30 +52,58 @@ func registerChannelTools(s *server.MCPServer, client slack.Service, readOnly bo
 
 	s.AddTool(mcp.NewTool("archive_channel",
 		mcp.WithDescription("Archive a Slack channel"),
-		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID")),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
 	), makeArchiveChannel(client))
 
+	s.AddTool(mcp.NewTool("unarchive_channel",
+		mcp.WithDescription("Unarchive a channel"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+	), makeUnarchiveChannel(client))
+
+	s.AddTool(mcp.NewTool("join_channel",
+		mcp.WithDescription("Join a public channel as the authenticated user"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+	), makeJoinChannel(client))
+
+	s.AddTool(mcp.NewTool("leave_channel",
+		mcp.WithDescription("Leave a channel as the authenticated user"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+	), makeLeaveChannel(client))
+
+	s.AddTool(mcp.NewTool("rename_channel",
+		mcp.WithDescription("Rename a channel"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+		mcp.WithString("name", mcp.Required(), mcp.Description("New channel name")),
+	), makeRenameChannel(client))
+
+	s.AddTool(mcp.NewTool("convert_channel",
+		mcp.WithDescription("Convert a channel between public and private; requires an Enterprise Grid admin token"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+		mcp.WithString("to", mcp.Required(), mcp.Enum("private", "public"), mcp.Description("Target visibility")),
+	), makeConvertChannel(client))
+
 	s.AddTool(mcp.NewTool("invite_to_channel",
-		mcp.WithDescription("Invite users to a channel"),
-		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID")),
-		mcp.WithArray("user_ids", mcp.Required(), mcp.Description("User IDs to invite")),
+		mcp.WithDescription("Invite users, or every member of a user group, to a channel"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+		mcp.WithArray("user_ids", mcp.Description("User IDs, @handles or emails to invite")),
+		mcp.WithString("usergroup_id", mcp.Description("User group ID whose members are also invited")),
 	), makeInviteToChannel(client))
 
 	s.AddTool(mcp.NewTool("kick_from_channel",
 		mcp.WithDescription("Remove a user from a channel"),
-		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID")),
-		mcp.WithString("user_id", mcp.Required(), mcp.Description("User ID to remove")),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+		mcp.WithString("user_id", mcp.Required(), mcp.Description("User ID, @handle or email to remove")),
 	), makeKickFromChannel(client))
 
 	s.AddTool(mcp.NewTool("set_channel_topic",
 		mcp.WithDescription("Set a channel's topic"),
-		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID")),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
 		mcp.WithString("topic", mcp.Required(), mcp.Description("New topic")),
 	), makeSetChannelTopic(client))
 
 	s.AddTool(mcp.NewTool("set_channel_purpose",
 		mcp.WithDescription("Set a channel's purpose"),
-		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID")),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
 		mcp.WithString("purpose", mcp.Required(), mcp.Description("New purpose")),
 	), makeSetChannelPurpose(client))
 }
@@ -74,10 +119,26 @@ func makeListChannels(client slack.Service) server.ToolHandlerFunc {
 		all := request.GetBool("all", false)
 		cursor := request.GetString("cursor", "")
 
-		result, err := client.ListChannels(slack.PaginationParams{
-			Cursor: cursor,
-			Limit:  limit,
-			All:    all,
+		result, err := client.ListChannels(ctx, slack.ListChannelsParams{
+			Pagination: slack.PaginationParams{Cursor: cursor, Limit: limit, All: all},
+		})
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(result)), nil
+	}
+}
+
+func makeListConversations(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		types := request.GetStringSlice("types", []string{"public_channel", "private_channel", "mpim", "im"})
+		limit := request.GetInt("limit", 100)
+		all := request.GetBool("all", false)
+		cursor := request.GetString("cursor", "")
+
+		result, err := client.ListChannels(ctx, slack.ListChannelsParams{
+			Types:      types,
+			Pagination: slack.PaginationParams{Cursor: cursor, Limit: limit, All: all},
 		})
 		if err != nil {
 			return errResult(err), nil
@@ -92,7 +153,7 @@ func makeGetChannelInfo(client slack.Service) server.ToolHandlerFunc {
 		if err != nil {
 			return errResult(err), nil
 		}
-		ch, err := client.GetChannelInfo(channelID)
+		ch, err := client.GetChannelInfo(ctx, channelID)
 		if err != nil {
 			return errResult(err), nil
 		}
@@ -100,6 +161,38 @@ func makeGetChannelInfo(client slack.Service) server.ToolHandlerFunc {
 	}
 }
 
+func makeListChannelMembers(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		result, err := client.ListChannelMembers(ctx, slack.ListChannelMembersParams{
+			ChannelID: channelID,
+			Pagination: slack.PaginationParams{
+				Cursor: request.GetString("cursor", ""),
+				Limit:  request.GetInt("limit", 100),
+				All:    request.GetBool("all", false),
+			},
+		})
+		if err != nil {
+			return errResult(err), nil
+		}
+		if !request.GetBool("resolve", false) {
+			return mcp.NewToolResultText(toJSON(result)), nil
+		}
+		users, err := client.GetUsers(ctx, result.Items)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(&slack.PaginatedResult[slack.User]{
+			Items:      users,
+			NextCursor: result.NextCursor,
+			HasMore:    result.HasMore,
+		})), nil
+	}
+}
+
 func makeCreateChannel(client slack.Service) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		name, err := request.RequireString("name")
@@ -107,7 +200,7 @@ func makeCreateChannel(client slack.Service) server.ToolHandlerFunc {
 			return errResult(err), nil
 		}
 		isPrivate := request.GetBool("is_private", false)
-		ch, err := client.CreateChannel(name, isPrivate)
+		ch, err := client.CreateChannel(ctx, name, isPrivate)
 		if err != nil {
 			return errResult(err), nil
 		}
@@ -121,24 +214,105 @@ func makeArchiveChannel(client slack.Service) server.ToolHandlerFunc {
 		if err != nil {
 			return errResult(err), nil
 		}
-		if err := client.ArchiveChannel(channelID); err != nil {
+		if err := client.ArchiveChannel(ctx, channelID); err != nil {
 			return errResult(err), nil
 		}
 		return mcp.NewToolResultText(toJSON(map[string]string{"status": "archived", "channel_id": channelID})), nil
 	}
 }
 
+func makeUnarchiveChannel(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		if err := client.UnarchiveChannel(ctx, channelID); err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(map[string]string{"status": "unarchived", "channel_id": channelID})), nil
+	}
+}
+
+func makeJoinChannel(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		ch, err := client.JoinChannel(ctx, channelID)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(ch)), nil
+	}
+}
+
+func makeLeaveChannel(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		if err := client.LeaveChannel(ctx, channelID); err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(map[string]string{"status": "left", "channel_id": channelID})), nil
+	}
+}
+
+func makeRenameChannel(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		name, err := request.RequireString("name")
+		if err != nil {
+			return errResult(err), nil
+		}
+		ch, err := client.RenameChannel(ctx, channelID, name)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(ch)), nil
+	}
+}
+
+func makeConvertChannel(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		to, err := request.RequireString("to")
+		if err != nil {
+			return errResult(err), nil
+		}
+		if to != "private" && to != "public" {
+			return mcp.NewToolResultError("to must be private or public"), nil
+		}
+		if err := client.ConvertChannel(ctx, channelID, to == "private"); err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(map[string]string{"status": "converted", "channel_id": channelID, "to": to})), nil
+	}
+}
+
 func makeInviteToChannel(client slack.Service) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		channelID, err := request.RequireString("channel_id")
 		if err != nil {
 			return errResult(err), nil
 		}
-		userIDs := request.GetStringSlice("user_ids", nil)
+		userIDs, err := slack.ChannelInvitees(ctx, client, request.GetStringSlice("user_ids", nil), request.GetString("usergroup_id", ""))
+		if err != nil {
+			return errResult(err), nil
+		}
 		if len(userIDs) == 0 {
-			return mcp.NewToolResultError("user_ids is required"), nil
+			return mcp.NewToolResultError("user_ids or usergroup_id is required"), nil
 		}
-		if err := client.InviteToChannel(channelID, userIDs...); err != nil {
+		if err := client.InviteToChannel(ctx, channelID, userIDs...); err != nil {
 			return errResult(err), nil
 		}
 		return mcp.NewToolResultText(toJSON(map[string]any{"status": "invited", "channel_id": channelID, "user_ids": userIDs})), nil
@@ -155,7 +329,7 @@ func makeKickFromChannel(client slack.Service) server.ToolHandlerFunc {
 		if err != nil {
 			return errResult(err), nil
 		}
-		if err := client.KickFromChannel(channelID, userID); err != nil {
+		if err := client.KickFromChannel(ctx, channelID, userID); err != nil {
 			return errResult(err), nil
 		}
 		return mcp.NewToolResultText(toJSON(map[string]string{"status": "removed", "channel_id": channelID, "user_id": userID})), nil
@@ -172,7 +346,7 @@ func makeSetChannelTopic(client slack.Service) server.ToolHandlerFunc {
 		if err != nil {
 			return errResult(err), nil
 		}
-		if err := client.SetChannelTopic(channelID, topic); err != nil {
+		if err := client.SetChannelTopic(ctx, channelID, topic); err != nil {
 			return errResult(err), nil
 		}
 		return mcp.NewToolResultText(toJSON(map[string]string{"status": "updated", "channel_id": channelID, "topic": topic})), nil
@@ -189,7 +363,7 @@ func makeSetChannelPurpose(client slack.Service) server.ToolHandlerFunc {
 		if err != nil {
 			return errResult(err), nil
 		}
-		if err := client.SetChannelPurpose(channelID, purpose); err != nil {
+		if err := client.SetChannelPurpose(ctx, channelID, purpose); err != nil {
 			return errResult(err), nil
 		}
 		return mcp.NewToolResultText(toJSON(map[string]string{"status": "updated", "channel_id": channelID, "purpose": purpose})), nil
@@ -25,10 +25,8 @@ func TestMakeListChannels(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().ListChannels(slack.PaginationParams{
-			Cursor: "",
-			Limit:  100,
-			All:    false,
+		mock.EXPECT().ListChannels(gomock.Any(), slack.ListChannelsParams{
+			Pagination: slack.PaginationParams{Cursor: "", Limit: 100, All: false},
 		}).Return(&slack.PaginatedResult[slack.Channel]{
 			Items: []slack.Channel{{ID: "C1", Name: "general"}},
 		}, nil)
@@ -45,7 +43,7 @@ func TestMakeListChannels(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().ListChannels(gomock.Any()).Return(nil, &slack.SlackError{
+		mock.EXPECT().ListChannels(gomock.Any(), gomock.Any()).Return(nil, &slack.SlackError{
 			Code: slack.ErrAuth, Message: "invalid_auth",
 		})
 
@@ -58,12 +56,50 @@ func TestMakeListChannels(t *testing.T) {
 	})
 }
 
+func TestMakeListConversations(t *testing.T) {
+	t.Run("defaults to all types", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListChannels(gomock.Any(), slack.ListChannelsParams{
+			Types:      []string{"public_channel", "private_channel", "mpim", "im"},
+			Pagination: slack.PaginationParams{Limit: 100},
+		}).Return(&slack.PaginatedResult[slack.Channel]{
+			Items: []slack.Channel{{ID: "D1", IsIM: true, User: "U1"}},
+		}, nil)
+
+		handler := makeListConversations(mock)
+		result, err := handler(context.Background(), newRequest(nil))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("filters by types", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListChannels(gomock.Any(), slack.ListChannelsParams{
+			Types:      []string{"im"},
+			Pagination: slack.PaginationParams{Limit: 100},
+		}).Return(&slack.PaginatedResult[slack.Channel]{}, nil)
+
+		handler := makeListConversations(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"types": []any{"im"},
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
 func TestMakeGetChannelInfo(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().GetChannelInfo("C123").Return(&slack.Channel{
+		mock.EXPECT().GetChannelInfo(gomock.Any(), "C123").Return(&slack.Channel{
 			ID: "C123", Name: "general",
 		}, nil)
 
@@ -88,12 +124,56 @@ func TestMakeGetChannelInfo(t *testing.T) {
 	})
 }
 
+func TestMakeListChannelMembers(t *testing.T) {
+	t.Run("ids", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListChannelMembers(gomock.Any(), slack.ListChannelMembersParams{
+			ChannelID:  "C123",
+			Pagination: slack.PaginationParams{Limit: 100},
+		}).Return(&slack.PaginatedResult[string]{Items: []string{"U1", "U2"}}, nil)
+
+		handler := makeListChannelMembers(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("resolve", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListChannelMembers(gomock.Any(), gomock.Any()).Return(&slack.PaginatedResult[string]{
+			Items: []string{"U1", "U2"}, NextCursor: "next", HasMore: true,
+		}, nil)
+		mock.EXPECT().GetUsers(gomock.Any(), []string{"U1", "U2"}).Return([]slack.User{
+			{ID: "U1", Name: "alice"}, {ID: "U2", Name: "bob"},
+		}, nil)
+
+		handler := makeListChannelMembers(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"resolve":    true,
+		}))
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		text := result.Content[0].(mcp.TextContent).Text
+		assert.Contains(t, text, `"name": "alice"`)
+		assert.Contains(t, text, `"next_cursor": "next"`)
+	})
+}
+
 func TestMakeCreateChannel(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().CreateChannel("dev", false).Return(&slack.Channel{
+		mock.EXPECT().CreateChannel(gomock.Any(), "dev", false).Return(&slack.Channel{
 			ID: "C456", Name: "dev",
 		}, nil)
 
@@ -112,7 +192,7 @@ func TestMakeArchiveChannel(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().ArchiveChannel("C123").Return(nil)
+		mock.EXPECT().ArchiveChannel(gomock.Any(), "C123").Return(nil)
 
 		handler := makeArchiveChannel(mock)
 		result, err := handler(context.Background(), newRequest(map[string]any{
@@ -124,12 +204,171 @@ func TestMakeArchiveChannel(t *testing.T) {
 	})
 }
 
+func TestMakeUnarchiveChannel(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().UnarchiveChannel(gomock.Any(), "C123").Return(nil)
+
+		handler := makeUnarchiveChannel(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestMakeJoinChannel(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().JoinChannel(gomock.Any(), "C123").Return(&slack.Channel{ID: "C123", Name: "general"}, nil)
+
+		handler := makeJoinChannel(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestMakeLeaveChannel(t *testing.T) {
+	t.Run("general", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().LeaveChannel(gomock.Any(), "C123").Return(&slack.SlackError{
+			Code: slack.ErrPermission, Message: "cant_leave_general",
+		})
+
+		handler := makeLeaveChannel(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestMakeRenameChannel(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().RenameChannel(gomock.Any(), "C123", "payments-eng").Return(&slack.Channel{ID: "C123", Name: "payments-eng"}, nil)
+
+		handler := makeRenameChannel(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"name":       "payments-eng",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestMakeConvertChannel(t *testing.T) {
+	t.Run("to private", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ConvertChannel(gomock.Any(), "C123", true).Return(nil)
+
+		handler := makeConvertChannel(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"to":         "private",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		handler := makeConvertChannel(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"to":         "secret",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestMakeInviteToChannel(t *testing.T) {
+	t.Run("expands user group", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListUserGroupMembers(gomock.Any(), "S123").Return([]string{"U2", "U3"}, nil)
+		mock.EXPECT().InviteToChannel(gomock.Any(), "C123", "U1", "U2", "U3").Return(nil)
+
+		handler := makeInviteToChannel(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id":   "C123",
+			"user_ids":     []any{"U1"},
+			"usergroup_id": "S123",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("user group overlapping explicit users invites each once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListUserGroupMembers(gomock.Any(), "S123").Return([]string{"U2", "U1", "U3"}, nil)
+		mock.EXPECT().InviteToChannel(gomock.Any(), "C123", "U1", "U2", "U3").Return(nil)
+
+		handler := makeInviteToChannel(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id":   "C123",
+			"user_ids":     []any{"U1", "U2", "U1"},
+			"usergroup_id": "S123",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, `"user_ids": [
+    "U1",
+    "U2",
+    "U3"
+  ]`)
+	})
+
+	t.Run("no users", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		handler := makeInviteToChannel(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
 func TestMakeSetChannelTopic(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().SetChannelTopic("C123", "new topic").Return(nil)
+		mock.EXPECT().SetChannelTopic(gomock.Any(), "C123", "new topic").Return(nil)
 
 		handler := makeSetChannelTopic(mock)
 		result, err := handler(context.Background(), newRequest(map[string]any{
@@ -147,7 +386,7 @@ func TestMakeSetChannelPurpose(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().SetChannelPurpose("C123", "new purpose").Return(nil)
+		mock.EXPECT().SetChannelPurpose(gomock.Any(), "C123", "new purpose").Return(nil)
 
 		handler := makeSetChannelPurpose(mock)
 		result, err := handler(context.Background(), newRequest(map[string]any{
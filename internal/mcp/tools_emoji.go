@@ -0,0 +1,26 @@
+package mcp
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/jackchuka/slackcli/internal/slack"
+)
+
+func registerEmojiTools(s *server.MCPServer, client slack.Service) {
+	s.AddTool(mcp.NewTool("list_emoji",
+		mcp.WithDescription("List the workspace's custom emoji; aliases include the emoji they point to"),
+	), makeListEmoji(client))
+}
+
+func makeListEmoji(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		emoji, err := client.ListEmoji(ctx)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(emoji)), nil
+	}
+}
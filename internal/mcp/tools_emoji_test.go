@@ -0,0 +1,45 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackchuka/slackcli/internal/slack"
+	"github.com/jackchuka/slackcli/internal/slack/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMakeListEmoji(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListEmoji(gomock.Any()).Return([]slack.Emoji{
+			{Name: "shipit", URL: "https://emoji.slack-edge.com/T1/shipit/abc.png"},
+			{Name: "squirrel", URL: "https://emoji.slack-edge.com/T1/shipit/abc.png", AliasFor: "shipit"},
+		}, nil)
+
+		handler := makeListEmoji(mock)
+		result, err := handler(context.Background(), newRequest(nil))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("missing scope", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListEmoji(gomock.Any()).Return(nil, &slack.SlackError{
+			Code: slack.ErrPermission, Message: "missing_scope",
+		})
+
+		handler := makeListEmoji(mock)
+		result, err := handler(context.Background(), newRequest(nil))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
@@ -12,8 +12,8 @@ import (
 func registerFileTools(s *server.MCPServer, client slack.Service, readOnly bool) {
 	s.AddTool(mcp.NewTool("list_files",
 		mcp.WithDescription("List files in Slack"),
-		mcp.WithString("channel_id", mcp.Description("Filter by channel ID")),
-		mcp.WithString("user_id", mcp.Description("Filter by user ID")),
+		mcp.WithString("channel_id", mcp.Description("Filter by channel ID or #name")),
+		mcp.WithString("user_id", mcp.Description("Filter by user ID, @handle or email")),
 		mcp.WithNumber("limit", mcp.Description("Max files to return"), mcp.DefaultNumber(100)),
 	), makeListFiles(client))
 
@@ -38,7 +38,7 @@ func makeListFiles(client slack.Service) server.ToolHandlerFunc {
 		userID := request.GetString("user_id", "")
 		limit := request.GetInt("limit", 100)
 
-		result, err := client.ListFiles(slack.PaginationParams{Limit: limit}, channelID, userID)
+		result, err := client.ListFiles(ctx, slack.PaginationParams{Limit: limit}, channelID, userID)
 		if err != nil {
 			return errResult(err), nil
 		}
@@ -52,7 +52,7 @@ func makeGetFileInfo(client slack.Service) server.ToolHandlerFunc {
 		if err != nil {
 			return errResult(err), nil
 		}
-		file, err := client.GetFileInfo(fileID)
+		file, err := client.GetFileInfo(ctx, fileID)
 		if err != nil {
 			return errResult(err), nil
 		}
@@ -66,7 +66,7 @@ func makeDeleteFile(client slack.Service) server.ToolHandlerFunc {
 		if err != nil {
 			return errResult(err), nil
 		}
-		if err := client.DeleteFile(fileID); err != nil {
+		if err := client.DeleteFile(ctx, fileID); err != nil {
 			return errResult(err), nil
 		}
 		return mcp.NewToolResultText(toJSON(map[string]string{"status": "deleted", "file_id": fileID})), nil
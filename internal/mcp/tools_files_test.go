@@ -16,7 +16,7 @@ func TestMakeListFiles(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().ListFiles(
+		mock.EXPECT().ListFiles(gomock.Any(),
 			slack.PaginationParams{Limit: 100}, "", "",
 		).Return(&slack.PaginatedResult[slack.File]{
 			Items: []slack.File{{ID: "F1", Name: "doc.pdf"}},
@@ -33,7 +33,7 @@ func TestMakeListFiles(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().ListFiles(
+		mock.EXPECT().ListFiles(gomock.Any(),
 			slack.PaginationParams{Limit: 50}, "C123", "U456",
 		).Return(&slack.PaginatedResult[slack.File]{Items: nil}, nil)
 
@@ -54,7 +54,7 @@ func TestMakeGetFileInfo(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().GetFileInfo("F123").Return(&slack.File{
+		mock.EXPECT().GetFileInfo(gomock.Any(), "F123").Return(&slack.File{
 			ID: "F123", Name: "report.pdf",
 		}, nil)
 
@@ -84,7 +84,7 @@ func TestMakeDeleteFile(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().DeleteFile("F123").Return(nil)
+		mock.EXPECT().DeleteFile(gomock.Any(), "F123").Return(nil)
 
 		handler := makeDeleteFile(mock)
 		result, err := handler(context.Background(), newRequest(map[string]any{
@@ -2,6 +2,9 @@ package mcp
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -12,36 +15,97 @@ import (
 func registerMessageTools(s *server.MCPServer, client slack.Service, readOnly bool) {
 	s.AddTool(mcp.NewTool("list_messages",
 		mcp.WithDescription("List messages in a Slack channel"),
-		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID")),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
 		mcp.WithNumber("limit", mcp.Description("Max messages to return"), mcp.DefaultNumber(100)),
 		mcp.WithBoolean("all", mcp.Description("Fetch all messages")),
 		mcp.WithString("cursor", mcp.Description("Pagination cursor")),
+		mcp.WithBoolean("include_permalinks", mcp.Description("Include each message's permalink (one extra API call per message)")),
+		withResolve(),
 	), makeListMessages(client))
 
+	s.AddTool(mcp.NewTool("get_message_by_permalink",
+		mcp.WithDescription("Get the message a Slack link such as https://acme.slack.com/archives/C123/p1700000000123456 points to"),
+		mcp.WithString("permalink", mcp.Required(), mcp.Description("Message permalink")),
+	), makeGetMessageByPermalink(client))
+
+	s.AddTool(mcp.NewTool("get_thread",
+		mcp.WithDescription("Get a thread's parent message followed by its replies, oldest first"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+		mcp.WithString("thread_ts", mcp.Required(), mcp.Description("Timestamp of the thread's parent message")),
+		mcp.WithNumber("limit", mcp.Description("Max messages to return"), mcp.DefaultNumber(100)),
+		mcp.WithBoolean("all", mcp.Description("Fetch the whole thread")),
+		mcp.WithString("cursor", mcp.Description("Pagination cursor")),
+		mcp.WithString("oldest", mcp.Description("Only replies after this time: RFC 3339, a message timestamp, or a duration ago such as 2h or 7d")),
+		mcp.WithString("latest", mcp.Description("Only replies before this time, in the same forms as oldest")),
+		withResolve(),
+	), makeGetThread(client))
+
+	s.AddTool(mcp.NewTool("list_scheduled_messages",
+		mcp.WithDescription("List messages that are scheduled but not yet posted"),
+		mcp.WithString("channel_id", mcp.Description("Only list messages scheduled in this channel")),
+		mcp.WithNumber("limit", mcp.Description("Max messages to return"), mcp.DefaultNumber(100)),
+		mcp.WithBoolean("all", mcp.Description("Fetch all scheduled messages")),
+		mcp.WithString("cursor", mcp.Description("Pagination cursor")),
+	), makeListScheduledMessages(client))
+
 	if readOnly {
 		return
 	}
 
 	s.AddTool(mcp.NewTool("send_message",
 		mcp.WithDescription("Send a message to a Slack channel"),
-		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID")),
-		mcp.WithString("text", mcp.Required(), mcp.Description("Message text")),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+		mcp.WithString("text", mcp.Description("Message text; the notification fallback when blocks are given")),
+		mcp.WithString("blocks", mcp.Description("Block Kit blocks as a JSON array")),
+		mcp.WithString("attachments", mcp.Description("Legacy attachments as a JSON array")),
 		mcp.WithString("thread_ts", mcp.Description("Thread timestamp for replies")),
 		mcp.WithBoolean("reply_broadcast", mcp.Description("When replying in a thread, also post the message to the channel")),
+		mcp.WithBoolean("include_permalink", mcp.Description("Include the posted message's permalink")),
 	), makeSendMessage(client))
 
+	s.AddTool(mcp.NewTool("send_direct_message",
+		mcp.WithDescription("Send a direct message to one user, or a group direct message to several users"),
+		mcp.WithArray("user_ids", mcp.Required(), mcp.Description("User IDs, @handles or emails to message"), mcp.WithStringItems()),
+		mcp.WithString("text", mcp.Required(), mcp.Description("Message text")),
+	), makeSendDirectMessage(client))
+
+	s.AddTool(mcp.NewTool("send_ephemeral_message",
+		mcp.WithDescription("Send a message to a channel that only one user can see"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+		mcp.WithString("user_id", mcp.Required(), mcp.Description("User who will see the message: ID, @handle or email")),
+		mcp.WithString("text", mcp.Description("Message text; the notification fallback when blocks are given")),
+		mcp.WithString("blocks", mcp.Description("Block Kit blocks as a JSON array")),
+		mcp.WithString("thread_ts", mcp.Description("Thread timestamp to show the message in")),
+	), makeSendEphemeralMessage(client))
+
 	s.AddTool(mcp.NewTool("edit_message",
 		mcp.WithDescription("Edit an existing message"),
-		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID")),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
 		mcp.WithString("timestamp", mcp.Required(), mcp.Description("Message timestamp")),
-		mcp.WithString("text", mcp.Required(), mcp.Description("New message text")),
+		mcp.WithString("text", mcp.Description("New message text; the notification fallback when blocks are given")),
+		mcp.WithString("blocks", mcp.Description("New Block Kit blocks as a JSON array")),
+		mcp.WithString("attachments", mcp.Description("New legacy attachments as a JSON array")),
 	), makeEditMessage(client))
 
 	s.AddTool(mcp.NewTool("delete_message",
 		mcp.WithDescription("Delete a message"),
-		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID")),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
 		mcp.WithString("timestamp", mcp.Required(), mcp.Description("Message timestamp")),
 	), makeDeleteMessage(client))
+
+	s.AddTool(mcp.NewTool("schedule_message",
+		mcp.WithDescription("Schedule a message to be posted to a Slack channel later"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+		mcp.WithString("text", mcp.Required(), mcp.Description("Message text")),
+		mcp.WithString("post_at", mcp.Required(), mcp.Description("When to post: RFC 3339 time or relative duration like 30m, 2h, 1d")),
+		mcp.WithString("thread_ts", mcp.Description("Thread timestamp for replies")),
+	), makeScheduleMessage(client))
+
+	s.AddTool(mcp.NewTool("cancel_scheduled_message",
+		mcp.WithDescription("Cancel a scheduled message before it is posted"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+		mcp.WithString("id", mcp.Required(), mcp.Description("Scheduled message ID")),
+	), makeCancelScheduledMessage(client))
 }
 
 func makeListMessages(client slack.Service) server.ToolHandlerFunc {
@@ -53,36 +117,210 @@ func makeListMessages(client slack.Service) server.ToolHandlerFunc {
 		limit := request.GetInt("limit", 100)
 		all := request.GetBool("all", false)
 		cursor := request.GetString("cursor", "")
+		permalinks := request.GetBool("include_permalinks", false)
+
+		result, err := client.ListMessages(ctx, slack.ListMessagesParams{
+			ChannelID:  channelID,
+			Pagination: slack.PaginationParams{Cursor: cursor, Limit: limit, All: all},
+			Permalinks: permalinks,
+		})
+		if err != nil {
+			return errResult(err), nil
+		}
+		if request.GetBool("resolve", false) {
+			slack.NewEnricher(client).Messages(ctx, result.Items)
+		}
+		return mcp.NewToolResultText(toJSON(result)), nil
+	}
+}
+
+func makeGetMessageByPermalink(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		permalink, err := request.RequireString("permalink")
+		if err != nil {
+			return errResult(err), nil
+		}
+		msg, err := client.GetMessageByPermalink(ctx, permalink)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(msg)), nil
+	}
+}
+
+func makeGetThread(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		threadTS, err := request.RequireString("thread_ts")
+		if err != nil {
+			return errResult(err), nil
+		}
+		limit := request.GetInt("limit", 100)
+		all := request.GetBool("all", false)
+		cursor := request.GetString("cursor", "")
+		oldest, err := timeBound(request, "oldest")
+		if err != nil {
+			return errResult(err), nil
+		}
+		latest, err := timeBound(request, "latest")
+		if err != nil {
+			return errResult(err), nil
+		}
 
-		result, err := client.ListMessages(slack.ListMessagesParams{
+		result, err := client.GetThread(ctx, slack.GetThreadParams{
 			ChannelID:  channelID,
+			ThreadTS:   threadTS,
 			Pagination: slack.PaginationParams{Cursor: cursor, Limit: limit, All: all},
+			Oldest:     oldest,
+			Latest:     latest,
 		})
 		if err != nil {
 			return errResult(err), nil
 		}
+		if request.GetBool("resolve", false) {
+			slack.NewEnricher(client).Messages(ctx, result.Items)
+		}
 		return mcp.NewToolResultText(toJSON(result)), nil
 	}
 }
 
+// timeBound parses an optional time range argument, returning the zero time
+// when it is absent.
+func timeBound(request mcp.CallToolRequest, key string) (time.Time, error) {
+	s := request.GetString(key, "")
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return slack.ParseTimeBound(s, time.Now())
+}
+
 func makeSendMessage(client slack.Service) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		channelID, err := request.RequireString("channel_id")
 		if err != nil {
 			return errResult(err), nil
 		}
-		text, err := request.RequireString("text")
+		text, blocks, attachments, err := messageContent(request)
 		if err != nil {
 			return errResult(err), nil
 		}
 		threadTS := request.GetString("thread_ts", "")
 		replyBroadcast := request.GetBool("reply_broadcast", false)
+		permalink := request.GetBool("include_permalink", false)
 
-		msg, err := client.SendMessage(slack.SendMessageParams{
+		msg, err := client.SendMessage(ctx, slack.SendMessageParams{
 			ChannelID:      channelID,
 			Text:           text,
 			ThreadTS:       threadTS,
 			ReplyBroadcast: replyBroadcast,
+			Blocks:         blocks,
+			Attachments:    attachments,
+			Permalink:      permalink,
+		})
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(msg)), nil
+	}
+}
+
+// withResolve adds the resolve argument of the tools that return messages.
+func withResolve() mcp.ToolOption {
+	return mcp.WithBoolean("resolve", mcp.Description("Add user_name and user_real_name, and render mentions, links and entities in text as readable names; the original is kept in raw_text"))
+}
+
+// messageContent reads the text, blocks and attachments arguments, at least
+// one of which is required. blocks and attachments may be given as a JSON
+// string or as the array itself.
+func messageContent(request mcp.CallToolRequest) (string, json.RawMessage, json.RawMessage, error) {
+	text := request.GetString("text", "")
+	blocks, err := jsonArg(request, "blocks")
+	if err != nil {
+		return "", nil, nil, err
+	}
+	if blocks != nil {
+		if err := slack.ValidateBlocks(blocks); err != nil {
+			return "", nil, nil, err
+		}
+	}
+	attachments, err := jsonArg(request, "attachments")
+	if err != nil {
+		return "", nil, nil, err
+	}
+	if text == "" && blocks == nil && attachments == nil {
+		return "", nil, nil, errors.New("one of text, blocks or attachments is required")
+	}
+	return text, blocks, attachments, nil
+}
+
+func jsonArg(request mcp.CallToolRequest, key string) (json.RawMessage, error) {
+	switch v := request.GetArguments()[key].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+		if !json.Valid([]byte(v)) {
+			return nil, errors.New(key + " is not valid JSON")
+		}
+		return json.RawMessage(v), nil
+	default:
+		return json.Marshal(v)
+	}
+}
+
+func makeSendDirectMessage(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userIDs := request.GetStringSlice("user_ids", nil)
+		if len(userIDs) == 0 {
+			return mcp.NewToolResultError("user_ids is required"), nil
+		}
+		text, err := request.RequireString("text")
+		if err != nil {
+			return errResult(err), nil
+		}
+
+		ch, err := client.OpenConversation(ctx, userIDs...)
+		if err != nil {
+			return errResult(err), nil
+		}
+		msg, err := client.SendMessage(ctx, slack.SendMessageParams{
+			ChannelID: ch.ID,
+			Text:      text,
+		})
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(msg)), nil
+	}
+}
+
+func makeSendEphemeralMessage(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		userID, err := request.RequireString("user_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		text, blocks, _, err := messageContent(request)
+		if err != nil {
+			return errResult(err), nil
+		}
+		threadTS := request.GetString("thread_ts", "")
+
+		msg, err := client.PostEphemeral(ctx, slack.PostEphemeralParams{
+			ChannelID: channelID,
+			UserID:    userID,
+			Text:      text,
+			Blocks:    blocks,
+			ThreadTS:  threadTS,
 		})
 		if err != nil {
 			return errResult(err), nil
@@ -101,12 +339,18 @@ func makeEditMessage(client slack.Service) server.ToolHandlerFunc {
 		if err != nil {
 			return errResult(err), nil
 		}
-		text, err := request.RequireString("text")
+		text, blocks, attachments, err := messageContent(request)
 		if err != nil {
 			return errResult(err), nil
 		}
 
-		msg, err := client.EditMessage(channelID, timestamp, text)
+		msg, err := client.EditMessage(ctx, slack.EditMessageParams{
+			ChannelID:   channelID,
+			Timestamp:   timestamp,
+			Text:        text,
+			Blocks:      blocks,
+			Attachments: attachments,
+		})
 		if err != nil {
 			return errResult(err), nil
 		}
@@ -124,9 +368,81 @@ func makeDeleteMessage(client slack.Service) server.ToolHandlerFunc {
 		if err != nil {
 			return errResult(err), nil
 		}
-		if err := client.DeleteMessage(channelID, timestamp); err != nil {
+		if err := client.DeleteMessage(ctx, channelID, timestamp); err != nil {
 			return errResult(err), nil
 		}
 		return mcp.NewToolResultText(toJSON(map[string]string{"status": "deleted", "channel_id": channelID, "timestamp": timestamp})), nil
 	}
 }
+
+func makeListScheduledMessages(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID := request.GetString("channel_id", "")
+		limit := request.GetInt("limit", 100)
+		all := request.GetBool("all", false)
+		cursor := request.GetString("cursor", "")
+
+		result, err := client.ListScheduledMessages(ctx, slack.ListScheduledMessagesParams{
+			ChannelID:  channelID,
+			Pagination: slack.PaginationParams{Cursor: cursor, Limit: limit, All: all},
+		})
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(result)), nil
+	}
+}
+
+func makeScheduleMessage(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		text, err := request.RequireString("text")
+		if err != nil {
+			return errResult(err), nil
+		}
+		at, err := request.RequireString("post_at")
+		if err != nil {
+			return errResult(err), nil
+		}
+		postAt, err := slack.ParseTime(at, time.Now())
+		if err != nil {
+			return errResult(err), nil
+		}
+		threadTS := request.GetString("thread_ts", "")
+
+		msg, err := client.ScheduleMessage(ctx, slack.ScheduleMessageParams{
+			ChannelID: channelID,
+			Text:      text,
+			ThreadTS:  threadTS,
+			PostAt:    postAt,
+		})
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(msg)), nil
+	}
+}
+
+func makeCancelScheduledMessage(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		id, err := request.RequireString("id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		if err := client.DeleteScheduledMessage(ctx, channelID, id); err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(map[string]string{
+			"status":  "cancelled",
+			"channel": channelID,
+			"id":      id,
+		})), nil
+	}
+}
@@ -2,10 +2,13 @@ package mcp
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/jackchuka/slackcli/internal/slack"
 	"github.com/jackchuka/slackcli/internal/slack/mocks"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -16,7 +19,7 @@ func TestMakeListMessages(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().ListMessages(slack.ListMessagesParams{
+		mock.EXPECT().ListMessages(gomock.Any(), slack.ListMessagesParams{
 			ChannelID:  "C123",
 			Pagination: slack.PaginationParams{Cursor: "", Limit: 100, All: false},
 		}).Return(&slack.PaginatedResult[slack.Message]{
@@ -32,6 +35,55 @@ func TestMakeListMessages(t *testing.T) {
 		assert.False(t, result.IsError)
 	})
 
+	t.Run("with permalinks", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListMessages(gomock.Any(), slack.ListMessagesParams{
+			ChannelID:  "C123",
+			Pagination: slack.PaginationParams{Limit: 100},
+			Permalinks: true,
+		}).Return(&slack.PaginatedResult[slack.Message]{
+			Items: []slack.Message{{Text: "hello", Permalink: "https://acme.slack.com/archives/C123/p1700000000000100"}},
+		}, nil)
+
+		handler := makeListMessages(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id":         "C123",
+			"include_permalinks": true,
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "p1700000000000100")
+	})
+
+	t.Run("resolve", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListMessages(gomock.Any(), gomock.Any()).Return(&slack.PaginatedResult[slack.Message]{
+			Items: []slack.Message{{Text: "<@U2> ship it", User: "U1"}},
+		}, nil)
+		mock.EXPECT().GetUsers(gomock.Any(), []string{"U1", "U2"}).Return([]slack.User{
+			{ID: "U1", Name: "alice", RealName: "Alice Smith"},
+			{ID: "U2", Name: "bob"},
+		}, nil)
+
+		handler := makeListMessages(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"resolve":    true,
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		text := result.Content[0].(mcp.TextContent).Text
+		assert.Contains(t, text, `"user_name": "alice"`)
+		assert.Contains(t, text, `"text": "@bob ship it"`)
+		assert.Contains(t, text, `"raw_text": "\u003c@U2\u003e ship it"`)
+	})
+
 	t.Run("missing channel_id", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
@@ -44,12 +96,131 @@ func TestMakeListMessages(t *testing.T) {
 	})
 }
 
+func TestMakeGetMessageByPermalink(t *testing.T) {
+	link := "https://acme.slack.com/archives/C123/p1700000000123456"
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().GetMessageByPermalink(gomock.Any(), link).Return(&slack.Message{
+			Channel: "C123", Timestamp: "1700000000.123456", Text: "hello", Permalink: link,
+		}, nil)
+
+		handler := makeGetMessageByPermalink(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"permalink": link,
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "hello")
+	})
+
+	t.Run("invalid permalink", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().GetMessageByPermalink(gomock.Any(), "not a link").Return(nil, &slack.SlackError{
+			Code: slack.ErrValidation, Message: "invalid_permalink",
+		})
+
+		handler := makeGetMessageByPermalink(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"permalink": "not a link",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestMakeGetThread(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().GetThread(gomock.Any(), slack.GetThreadParams{
+			ChannelID:  "C123",
+			ThreadTS:   "1111.2222",
+			Pagination: slack.PaginationParams{Cursor: "", Limit: 100, All: true},
+		}).Return(&slack.PaginatedResult[slack.Message]{
+			Items: []slack.Message{
+				{Text: "parent", Timestamp: "1111.2222", ThreadTS: "1111.2222"},
+				{Text: "reply", Timestamp: "1111.3333", ThreadTS: "1111.2222"},
+			},
+		}, nil)
+
+		handler := makeGetThread(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"thread_ts":  "1111.2222",
+			"all":        true,
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("time bounds", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().GetThread(gomock.Any(), slack.GetThreadParams{
+			ChannelID:  "C123",
+			ThreadTS:   "1111.2222",
+			Pagination: slack.PaginationParams{Limit: 100},
+			Oldest:     time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+			Latest:     time.Unix(1705312800, 500000),
+		}).Return(&slack.PaginatedResult[slack.Message]{}, nil)
+
+		handler := makeGetThread(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"thread_ts":  "1111.2222",
+			"oldest":     "2024-01-15T09:00:00Z",
+			"latest":     "1705312800.000500",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("invalid bound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		handler := makeGetThread(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"thread_ts":  "1111.2222",
+			"oldest":     "last week",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+
+	t.Run("missing thread_ts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		handler := makeGetThread(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
 func TestMakeSendMessage(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().SendMessage(slack.SendMessageParams{
+		mock.EXPECT().SendMessage(gomock.Any(), slack.SendMessageParams{
 			ChannelID: "C123",
 			Text:      "hello",
 			ThreadTS:  "",
@@ -73,7 +244,7 @@ func TestMakeSendMessage(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().SendMessage(slack.SendMessageParams{
+		mock.EXPECT().SendMessage(gomock.Any(), slack.SendMessageParams{
 			ChannelID:      "C123",
 			Text:           "hello",
 			ThreadTS:       "1111.2222",
@@ -98,12 +269,150 @@ func TestMakeSendMessage(t *testing.T) {
 	})
 }
 
+func TestMakeSendMessage_Blocks(t *testing.T) {
+	t.Run("blocks as array", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().SendMessage(gomock.Any(), slack.SendMessageParams{
+			ChannelID: "C123",
+			Text:      "status",
+			Blocks:    json.RawMessage(`[{"text":{"text":"*Deploy* done","type":"mrkdwn"},"type":"section"}]`),
+		}).Return(&slack.Message{Channel: "C123", Timestamp: "1234.5678"}, nil)
+
+		handler := makeSendMessage(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"text":       "status",
+			"blocks": []any{
+				map[string]any{"type": "section", "text": map[string]any{"type": "mrkdwn", "text": "*Deploy* done"}},
+			},
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("invalid blocks are rejected locally", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		handler := makeSendMessage(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"blocks":     `[{"type":"section"}]`,
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, `blocks[0] (section): "text" or "fields" is required`)
+	})
+}
+
+func TestMakeSendDirectMessage(t *testing.T) {
+	t.Run("opens a group DM then sends", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		gomock.InOrder(
+			mock.EXPECT().OpenConversation(gomock.Any(), "U1", "U2").Return(&slack.Channel{ID: "G123", IsMpIM: true}, nil),
+			mock.EXPECT().SendMessage(gomock.Any(), slack.SendMessageParams{
+				ChannelID: "G123",
+				Text:      "hello",
+			}).Return(&slack.Message{Channel: "G123", Text: "hello", Timestamp: "1234.5678"}, nil),
+		)
+
+		handler := makeSendDirectMessage(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"user_ids": []any{"U1", "U2"},
+			"text":     "hello",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("open fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().OpenConversation(gomock.Any(), "U1").Return(nil, &slack.SlackError{
+			Code: slack.ErrNotFound, Message: "user_not_found",
+		})
+
+		handler := makeSendDirectMessage(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"user_ids": []any{"U1"},
+			"text":     "hello",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+
+	t.Run("missing user_ids", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		handler := makeSendDirectMessage(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"text": "hello",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestMakeSendEphemeralMessage(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().PostEphemeral(gomock.Any(), slack.PostEphemeralParams{
+			ChannelID: "C123",
+			UserID:    "U123",
+			Text:      "only you can see this",
+			ThreadTS:  "1111.2222",
+		}).Return(&slack.Message{Channel: "C123", User: "U123", Timestamp: "1234.5678", Type: "ephemeral"}, nil)
+
+		handler := makeSendEphemeralMessage(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"user_id":    "U123",
+			"text":       "only you can see this",
+			"thread_ts":  "1111.2222",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("missing user_id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		handler := makeSendEphemeralMessage(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"text":       "hi",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
 func TestMakeEditMessage(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().EditMessage("C123", "1234.5678", "updated text").Return(&slack.Message{
+		mock.EXPECT().EditMessage(gomock.Any(), slack.EditMessageParams{
+			ChannelID: "C123",
+			Timestamp: "1234.5678",
+			Text:      "updated text",
+		}).Return(&slack.Message{
 			Channel: "C123", Timestamp: "1234.5678", Text: "updated text",
 		}, nil)
 
@@ -117,6 +426,42 @@ func TestMakeEditMessage(t *testing.T) {
 		require.NoError(t, err)
 		assert.False(t, result.IsError)
 	})
+
+	t.Run("replaces blocks", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		blocks := `[{"type":"divider"}]`
+		mock.EXPECT().EditMessage(gomock.Any(), slack.EditMessageParams{
+			ChannelID: "C123",
+			Timestamp: "1234.5678",
+			Blocks:    json.RawMessage(blocks),
+		}).Return(&slack.Message{Channel: "C123", Timestamp: "1234.5678"}, nil)
+
+		handler := makeEditMessage(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"timestamp":  "1234.5678",
+			"blocks":     blocks,
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("missing content", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		handler := makeEditMessage(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"timestamp":  "1234.5678",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
 }
 
 func TestMakeDeleteMessage(t *testing.T) {
@@ -124,7 +469,7 @@ func TestMakeDeleteMessage(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().DeleteMessage("C123", "1234.5678").Return(nil)
+		mock.EXPECT().DeleteMessage(gomock.Any(), "C123", "1234.5678").Return(nil)
 
 		handler := makeDeleteMessage(mock)
 		result, err := handler(context.Background(), newRequest(map[string]any{
@@ -136,3 +481,103 @@ func TestMakeDeleteMessage(t *testing.T) {
 		assert.False(t, result.IsError)
 	})
 }
+
+func TestMakeListScheduledMessages(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListScheduledMessages(gomock.Any(), slack.ListScheduledMessagesParams{
+			ChannelID:  "C123",
+			Pagination: slack.PaginationParams{Limit: 100},
+		}).Return(&slack.PaginatedResult[slack.ScheduledMessage]{
+			Items: []slack.ScheduledMessage{{ID: "Q123", Channel: "C123", PostAt: 1700000000}},
+		}, nil)
+
+		handler := makeListScheduledMessages(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestMakeScheduleMessage(t *testing.T) {
+	t.Run("absolute time", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ScheduleMessage(gomock.Any(), slack.ScheduleMessageParams{
+			ChannelID: "C123",
+			Text:      "release notes",
+			PostAt:    time.Date(2030, 1, 2, 9, 0, 0, 0, time.UTC),
+		}).Return(&slack.ScheduledMessage{ID: "Q123", Channel: "C123", PostAt: 1893574800}, nil)
+
+		handler := makeScheduleMessage(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"text":       "release notes",
+			"post_at":    "2030-01-02T09:00:00Z",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("relative time", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		before := time.Now()
+		mock.EXPECT().ScheduleMessage(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, params slack.ScheduleMessageParams) (*slack.ScheduledMessage, error) {
+				assert.WithinDuration(t, before.Add(30*time.Minute), params.PostAt, 5*time.Second)
+				return &slack.ScheduledMessage{ID: "Q123"}, nil
+			})
+
+		handler := makeScheduleMessage(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"text":       "soon",
+			"post_at":    "30m",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("invalid time", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		handler := makeScheduleMessage(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"text":       "soon",
+			"post_at":    "tomorrow",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestMakeCancelScheduledMessage(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().DeleteScheduledMessage(gomock.Any(), "C123", "Q123").Return(nil)
+
+		handler := makeCancelScheduledMessage(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"id":         "Q123",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
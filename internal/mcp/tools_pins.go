@@ -0,0 +1,81 @@
+package mcp
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/jackchuka/slackcli/internal/slack"
+)
+
+func registerPinTools(s *server.MCPServer, client slack.Service, readOnly bool) {
+	s.AddTool(mcp.NewTool("list_pins",
+		mcp.WithDescription("List the messages and files pinned to a channel, with full message content"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+	), makeListPins(client))
+
+	if readOnly {
+		return
+	}
+
+	s.AddTool(mcp.NewTool("pin_message",
+		mcp.WithDescription("Pin a message to its channel"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+		mcp.WithString("timestamp", mcp.Required(), mcp.Description("Message timestamp")),
+	), makePinMessage(client))
+
+	s.AddTool(mcp.NewTool("unpin_message",
+		mcp.WithDescription("Unpin a message from its channel"),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
+		mcp.WithString("timestamp", mcp.Required(), mcp.Description("Message timestamp")),
+	), makeUnpinMessage(client))
+}
+
+func makeListPins(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		items, err := client.ListPins(ctx, channelID)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(items)), nil
+	}
+}
+
+func makePinMessage(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		timestamp, err := request.RequireString("timestamp")
+		if err != nil {
+			return errResult(err), nil
+		}
+		if err := client.AddPin(ctx, channelID, timestamp); err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(map[string]string{"status": "pinned"})), nil
+	}
+}
+
+func makeUnpinMessage(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		channelID, err := request.RequireString("channel_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		timestamp, err := request.RequireString("timestamp")
+		if err != nil {
+			return errResult(err), nil
+		}
+		if err := client.RemovePin(ctx, channelID, timestamp); err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(map[string]string{"status": "unpinned"})), nil
+	}
+}
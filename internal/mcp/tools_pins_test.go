@@ -0,0 +1,102 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackchuka/slackcli/internal/slack"
+	"github.com/jackchuka/slackcli/internal/slack/mocks"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMakeListPins(t *testing.T) {
+	t.Run("returns full messages", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListPins(gomock.Any(), "C123").Return([]slack.PinnedItem{
+			{
+				Type:    "message",
+				Channel: "C123",
+				Message: &slack.Message{Channel: "C123", Timestamp: "1234.5678", Text: "runbook", Type: "message"},
+			},
+		}, nil)
+
+		handler := makeListPins(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, `"text": "runbook"`)
+	})
+
+	t.Run("missing channel_id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		handler := makeListPins(mock)
+		result, err := handler(context.Background(), newRequest(nil))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestMakePinMessage(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().AddPin(gomock.Any(), "C123", "1234.5678").Return(nil)
+
+		handler := makePinMessage(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"timestamp":  "1234.5678",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().AddPin(gomock.Any(), "C123", "1234.5678").Return(
+			&slack.SlackError{Code: slack.ErrValidation, Message: "already_pinned"},
+		)
+
+		handler := makePinMessage(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"timestamp":  "1234.5678",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestMakeUnpinMessage(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().RemovePin(gomock.Any(), "C123", "1234.5678").Return(nil)
+
+		handler := makeUnpinMessage(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"channel_id": "C123",
+			"timestamp":  "1234.5678",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
@@ -12,7 +12,7 @@ import (
 func registerReactionTools(s *server.MCPServer, client slack.Service, readOnly bool) {
 	s.AddTool(mcp.NewTool("list_reactions",
 		mcp.WithDescription("List reactions for a user"),
-		mcp.WithString("user_id", mcp.Description("User ID (defaults to authenticated user)")),
+		mcp.WithString("user_id", mcp.Description("User ID, @handle or email (defaults to authenticated user)")),
 		mcp.WithNumber("limit", mcp.Description("Max items to return"), mcp.DefaultNumber(100)),
 	), makeListReactions(client))
 
@@ -22,16 +22,16 @@ func registerReactionTools(s *server.MCPServer, client slack.Service, readOnly b
 
 	s.AddTool(mcp.NewTool("add_reaction",
 		mcp.WithDescription("Add an emoji reaction to a message"),
-		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID")),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
 		mcp.WithString("timestamp", mcp.Required(), mcp.Description("Message timestamp")),
-		mcp.WithString("name", mcp.Required(), mcp.Description("Emoji name (without colons)")),
+		mcp.WithString("name", mcp.Required(), mcp.Description("Emoji name, e.g. thumbsup or wave::skin-tone-2; unknown names are rejected with the closest match")),
 	), makeAddReaction(client))
 
 	s.AddTool(mcp.NewTool("remove_reaction",
 		mcp.WithDescription("Remove an emoji reaction from a message"),
-		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID")),
+		mcp.WithString("channel_id", mcp.Required(), mcp.Description("Channel ID or #name")),
 		mcp.WithString("timestamp", mcp.Required(), mcp.Description("Message timestamp")),
-		mcp.WithString("name", mcp.Required(), mcp.Description("Emoji name (without colons)")),
+		mcp.WithString("name", mcp.Required(), mcp.Description("Emoji name, e.g. thumbsup or wave::skin-tone-2; unknown names are rejected with the closest match")),
 	), makeRemoveReaction(client))
 }
 
@@ -49,7 +49,7 @@ func makeAddReaction(client slack.Service) server.ToolHandlerFunc {
 		if err != nil {
 			return errResult(err), nil
 		}
-		if err := client.AddReaction(channelID, timestamp, name); err != nil {
+		if err := client.AddReaction(ctx, channelID, timestamp, name); err != nil {
 			return errResult(err), nil
 		}
 		return mcp.NewToolResultText(toJSON(map[string]string{"status": "added", "reaction": name})), nil
@@ -70,7 +70,7 @@ func makeRemoveReaction(client slack.Service) server.ToolHandlerFunc {
 		if err != nil {
 			return errResult(err), nil
 		}
-		if err := client.RemoveReaction(channelID, timestamp, name); err != nil {
+		if err := client.RemoveReaction(ctx, channelID, timestamp, name); err != nil {
 			return errResult(err), nil
 		}
 		return mcp.NewToolResultText(toJSON(map[string]string{"status": "removed", "reaction": name})), nil
@@ -82,7 +82,7 @@ func makeListReactions(client slack.Service) server.ToolHandlerFunc {
 		userID := request.GetString("user_id", "")
 		limit := request.GetInt("limit", 100)
 
-		result, err := client.ListReactions(userID, slack.PaginationParams{Limit: limit})
+		result, err := client.ListReactions(ctx, userID, slack.PaginationParams{Limit: limit})
 		if err != nil {
 			return errResult(err), nil
 		}
@@ -16,7 +16,7 @@ func TestMakeAddReaction(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().AddReaction("C123", "1234.5678", "thumbsup").Return(nil)
+		mock.EXPECT().AddReaction(gomock.Any(), "C123", "1234.5678", "thumbsup").Return(nil)
 
 		handler := makeAddReaction(mock)
 		result, err := handler(context.Background(), newRequest(map[string]any{
@@ -33,7 +33,7 @@ func TestMakeAddReaction(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().AddReaction("C123", "1234.5678", "thumbsup").Return(
+		mock.EXPECT().AddReaction(gomock.Any(), "C123", "1234.5678", "thumbsup").Return(
 			&slack.SlackError{Code: slack.ErrNotFound, Message: "message_not_found"},
 		)
 
@@ -54,7 +54,7 @@ func TestMakeRemoveReaction(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().RemoveReaction("C123", "1234.5678", "thumbsup").Return(nil)
+		mock.EXPECT().RemoveReaction(gomock.Any(), "C123", "1234.5678", "thumbsup").Return(nil)
 
 		handler := makeRemoveReaction(mock)
 		result, err := handler(context.Background(), newRequest(map[string]any{
@@ -73,7 +73,7 @@ func TestMakeListReactions(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().ListReactions("U123", slack.PaginationParams{Limit: 100}).Return(
+		mock.EXPECT().ListReactions(gomock.Any(), "U123", slack.PaginationParams{Limit: 100}).Return(
 			&slack.PaginatedResult[slack.ReactedItem]{
 				Items: []slack.ReactedItem{{Type: "message"}},
 			}, nil)
@@ -0,0 +1,116 @@
+package mcp
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/jackchuka/slackcli/internal/slack"
+)
+
+func registerReminderTools(s *server.MCPServer, client slack.Service, readOnly bool) {
+	s.AddTool(mcp.NewTool("list_reminders",
+		mcp.WithDescription("List reminders created by or for the authenticated user"),
+	), makeListReminders(client))
+
+	s.AddTool(mcp.NewTool("get_reminder_info",
+		mcp.WithDescription("Get information about a reminder"),
+		mcp.WithString("reminder_id", mcp.Required(), mcp.Description("Reminder ID")),
+	), makeGetReminderInfo(client))
+
+	if readOnly {
+		return
+	}
+
+	s.AddTool(mcp.NewTool("add_reminder",
+		mcp.WithDescription("Create a reminder, e.g. \"in 2 hours\" to \"check the deploy\""),
+		mcp.WithString("text", mcp.Required(), mcp.Description("What to be reminded about")),
+		mcp.WithString("time", mcp.Required(), mcp.Description("When: natural language like \"in 2 hours\" or \"every Monday at 9am\", a Unix timestamp, or RFC 3339")),
+		mcp.WithString("user_id", mcp.Description("User ID, @handle or email to remind (defaults to authenticated user)")),
+	), makeAddReminder(client))
+
+	s.AddTool(mcp.NewTool("complete_reminder",
+		mcp.WithDescription("Mark a reminder as complete"),
+		mcp.WithString("reminder_id", mcp.Required(), mcp.Description("Reminder ID")),
+	), makeCompleteReminder(client))
+
+	s.AddTool(mcp.NewTool("delete_reminder",
+		mcp.WithDescription("Delete a reminder"),
+		mcp.WithString("reminder_id", mcp.Required(), mcp.Description("Reminder ID")),
+	), makeDeleteReminder(client))
+}
+
+func makeListReminders(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		reminders, err := client.ListReminders(ctx)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(reminders)), nil
+	}
+}
+
+func makeGetReminderInfo(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		reminderID, err := request.RequireString("reminder_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		reminder, err := client.GetReminderInfo(ctx, reminderID)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(reminder)), nil
+	}
+}
+
+func makeAddReminder(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		text, err := request.RequireString("text")
+		if err != nil {
+			return errResult(err), nil
+		}
+		when, err := request.RequireString("time")
+		if err != nil {
+			return errResult(err), nil
+		}
+		userID := request.GetString("user_id", "")
+
+		reminder, err := client.AddReminder(ctx, slack.AddReminderParams{
+			Text:   text,
+			Time:   when,
+			UserID: userID,
+		})
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(reminder)), nil
+	}
+}
+
+func makeCompleteReminder(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		reminderID, err := request.RequireString("reminder_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		if err := client.CompleteReminder(ctx, reminderID); err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(map[string]string{"status": "completed", "reminder": reminderID})), nil
+	}
+}
+
+func makeDeleteReminder(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		reminderID, err := request.RequireString("reminder_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		if err := client.DeleteReminder(ctx, reminderID); err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(map[string]string{"status": "deleted", "reminder": reminderID})), nil
+	}
+}
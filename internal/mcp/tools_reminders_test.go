@@ -0,0 +1,116 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackchuka/slackcli/internal/slack"
+	"github.com/jackchuka/slackcli/internal/slack/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMakeListReminders(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListReminders(gomock.Any()).Return([]slack.Reminder{
+			{ID: "Rm1", Text: "check the deploy", Time: 1700000000},
+		}, nil)
+
+		handler := makeListReminders(mock)
+		result, err := handler(context.Background(), newRequest(nil))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestMakeGetReminderInfo(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().GetReminderInfo(gomock.Any(), "Rm404").Return(nil, &slack.SlackError{
+			Code: slack.ErrNotFound, Message: "not_found",
+		})
+
+		handler := makeGetReminderInfo(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"reminder_id": "Rm404",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestMakeAddReminder(t *testing.T) {
+	t.Run("natural language time", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().AddReminder(gomock.Any(), slack.AddReminderParams{
+			Text: "check the deploy",
+			Time: "in 2 hours",
+		}).Return(&slack.Reminder{ID: "Rm1", Text: "check the deploy"}, nil)
+
+		handler := makeAddReminder(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"text": "check the deploy",
+			"time": "in 2 hours",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("missing time", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		handler := makeAddReminder(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"text": "check the deploy",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestMakeCompleteReminder(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().CompleteReminder(gomock.Any(), "Rm1").Return(nil)
+
+		handler := makeCompleteReminder(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"reminder_id": "Rm1",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestMakeDeleteReminder(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().DeleteReminder(gomock.Any(), "Rm1").Return(nil)
+
+		handler := makeDeleteReminder(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"reminder_id": "Rm1",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
@@ -15,29 +15,97 @@ func registerSearchTools(s *server.MCPServer, client slack.Service) {
 		mcp.WithString("query", mcp.Required(), mcp.Description("Search query")),
 		mcp.WithString("sort", mcp.Description("Sort field: timestamp or score"), mcp.DefaultString("timestamp")),
 		mcp.WithString("sort_dir", mcp.Description("Sort direction: asc or desc"), mcp.DefaultString("desc")),
-		mcp.WithNumber("limit", mcp.Description("Max results to return"), mcp.DefaultNumber(20)),
+		mcp.WithNumber("limit", mcp.Description("Results per page (max 100)"), mcp.DefaultNumber(20)),
+		mcp.WithNumber("page", mcp.Description("Page to fetch, starting at 1; use page+1 while has_more is true"), mcp.DefaultNumber(1)),
+		mcp.WithBoolean("all", mcp.Description("Fetch every page from page on, up to max_results")),
+		mcp.WithNumber("max_results", mcp.Description("Maximum results to fetch with all"), mcp.DefaultNumber(slack.DefaultSearchMaxResults)),
+		withResolve(),
 	), makeSearchMessages(client))
+
+	s.AddTool(mcp.NewTool("search_files",
+		mcp.WithDescription("Search for files in Slack"),
+		mcp.WithString("query", mcp.Required(), mcp.Description("Search query")),
+		mcp.WithString("sort", mcp.Description("Sort field: timestamp or score"), mcp.DefaultString("timestamp")),
+		mcp.WithString("sort_dir", mcp.Description("Sort direction: asc or desc"), mcp.DefaultString("desc")),
+		mcp.WithNumber("limit", mcp.Description("Results per page (max 100)"), mcp.DefaultNumber(20)),
+		mcp.WithNumber("page", mcp.Description("Page to fetch, starting at 1; use page+1 while has_more is true"), mcp.DefaultNumber(1)),
+		mcp.WithBoolean("all", mcp.Description("Fetch every page from page on, up to max_results")),
+		mcp.WithNumber("max_results", mcp.Description("Maximum results to fetch with all"), mcp.DefaultNumber(slack.DefaultSearchMaxResults)),
+	), makeSearchFiles(client))
+
+	s.AddTool(mcp.NewTool("search_all",
+		mcp.WithDescription("Search messages and files in one call"),
+		mcp.WithString("query", mcp.Required(), mcp.Description("Search query")),
+		mcp.WithString("sort", mcp.Description("Sort field: timestamp or score"), mcp.DefaultString("timestamp")),
+		mcp.WithString("sort_dir", mcp.Description("Sort direction: asc or desc"), mcp.DefaultString("desc")),
+		mcp.WithNumber("limit", mcp.Description("Results of each kind per page (max 100)"), mcp.DefaultNumber(20)),
+		mcp.WithNumber("page", mcp.Description("Page to fetch, starting at 1"), mcp.DefaultNumber(1)),
+		mcp.WithBoolean("all", mcp.Description("Fetch every page of messages and of files from page on, up to max_results of each")),
+		mcp.WithNumber("max_results", mcp.Description("Maximum results of each kind to fetch with all"), mcp.DefaultNumber(slack.DefaultSearchMaxResults)),
+		withResolve(),
+	), makeSearchAll(client))
+}
+
+// searchParams reads the arguments shared by the search tools.
+func searchParams(request mcp.CallToolRequest) (slack.SearchParams, error) {
+	query, err := request.RequireString("query")
+	if err != nil {
+		return slack.SearchParams{}, err
+	}
+	return slack.SearchParams{
+		Query:      query,
+		Sort:       request.GetString("sort", "timestamp"),
+		SortDir:    request.GetString("sort_dir", "desc"),
+		Page:       request.GetInt("page", 1),
+		Pagination: slack.PaginationParams{Limit: request.GetInt("limit", 20), All: request.GetBool("all", false)},
+		MaxResults: request.GetInt("max_results", slack.DefaultSearchMaxResults),
+	}, nil
 }
 
 func makeSearchMessages(client slack.Service) server.ToolHandlerFunc {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		query, err := request.RequireString("query")
+		params, err := searchParams(request)
+		if err != nil {
+			return errResult(err), nil
+		}
+		result, err := client.SearchMessages(ctx, params)
+		if err != nil {
+			return errResult(err), nil
+		}
+		if request.GetBool("resolve", false) {
+			slack.NewEnricher(client).Messages(ctx, result.Matches)
+		}
+		return mcp.NewToolResultText(toJSON(result)), nil
+	}
+}
+
+func makeSearchFiles(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		params, err := searchParams(request)
+		if err != nil {
+			return errResult(err), nil
+		}
+		result, err := client.SearchFiles(ctx, params)
 		if err != nil {
 			return errResult(err), nil
 		}
-		sort := request.GetString("sort", "timestamp")
-		sortDir := request.GetString("sort_dir", "desc")
-		limit := request.GetInt("limit", 20)
+		return mcp.NewToolResultText(toJSON(result)), nil
+	}
+}
 
-		result, err := client.SearchMessages(slack.SearchParams{
-			Query:      query,
-			Sort:       sort,
-			SortDir:    sortDir,
-			Pagination: slack.PaginationParams{Limit: limit},
-		})
+func makeSearchAll(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		params, err := searchParams(request)
 		if err != nil {
 			return errResult(err), nil
 		}
+		result, err := client.SearchAll(ctx, params)
+		if err != nil {
+			return errResult(err), nil
+		}
+		if request.GetBool("resolve", false) {
+			slack.NewEnricher(client).Messages(ctx, result.Messages.Matches)
+		}
 		return mcp.NewToolResultText(toJSON(result)), nil
 	}
 }
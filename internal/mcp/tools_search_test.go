@@ -6,6 +6,7 @@ import (
 
 	"github.com/jackchuka/slackcli/internal/slack"
 	"github.com/jackchuka/slackcli/internal/slack/mocks"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -16,11 +17,13 @@ func TestMakeSearchMessages(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().SearchMessages(slack.SearchParams{
+		mock.EXPECT().SearchMessages(gomock.Any(), slack.SearchParams{
 			Query:      "important",
 			Sort:       "timestamp",
 			SortDir:    "desc",
+			Page:       1,
 			Pagination: slack.PaginationParams{Limit: 20},
+			MaxResults: slack.DefaultSearchMaxResults,
 		}).Return(&slack.SearchResult{
 			Matches: []slack.Message{{Text: "this is important"}},
 			Total:   1,
@@ -35,6 +38,57 @@ func TestMakeSearchMessages(t *testing.T) {
 		assert.False(t, result.IsError)
 	})
 
+	t.Run("page", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().SearchMessages(gomock.Any(), slack.SearchParams{
+			Query:      "important",
+			Sort:       "timestamp",
+			SortDir:    "desc",
+			Page:       3,
+			Pagination: slack.PaginationParams{Limit: 100},
+			MaxResults: slack.DefaultSearchMaxResults,
+		}).Return(&slack.SearchResult{
+			Total:        250,
+			SearchPaging: slack.SearchPaging{Page: 3, Pages: 3, PerPage: 100},
+		}, nil)
+
+		handler := makeSearchMessages(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"query": "important",
+			"page":  float64(3),
+			"limit": float64(100),
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("all with cap", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().SearchMessages(gomock.Any(), slack.SearchParams{
+			Query:      "important",
+			Sort:       "timestamp",
+			SortDir:    "desc",
+			Page:       1,
+			Pagination: slack.PaginationParams{Limit: 20, All: true},
+			MaxResults: 200,
+		}).Return(&slack.SearchResult{}, nil)
+
+		handler := makeSearchMessages(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"query":       "important",
+			"all":         true,
+			"max_results": float64(200),
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
 	t.Run("missing query", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
@@ -46,3 +100,141 @@ func TestMakeSearchMessages(t *testing.T) {
 		assert.True(t, result.IsError)
 	})
 }
+
+func TestMakeSearchFiles(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().SearchFiles(gomock.Any(), slack.SearchParams{
+			Query:      "report",
+			Sort:       "score",
+			SortDir:    "desc",
+			Page:       1,
+			Pagination: slack.PaginationParams{Limit: 5},
+			MaxResults: slack.DefaultSearchMaxResults,
+		}).Return(&slack.SearchFilesResult{
+			Matches: []slack.File{{ID: "F123", Name: "report.pdf"}},
+			Total:   1,
+		}, nil)
+
+		handler := makeSearchFiles(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"query": "report",
+			"sort":  "score",
+			"limit": float64(5),
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("all with cap", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().SearchFiles(gomock.Any(), slack.SearchParams{
+			Query:      "report",
+			Sort:       "timestamp",
+			SortDir:    "desc",
+			Page:       1,
+			Pagination: slack.PaginationParams{Limit: 20, All: true},
+			MaxResults: 50,
+		}).Return(&slack.SearchFilesResult{}, nil)
+
+		handler := makeSearchFiles(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"query":       "report",
+			"all":         true,
+			"max_results": float64(50),
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestMakeSearchAll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().SearchAll(gomock.Any(), slack.SearchParams{
+			Query:      "deploy",
+			Sort:       "timestamp",
+			SortDir:    "desc",
+			Page:       1,
+			Pagination: slack.PaginationParams{Limit: 20},
+			MaxResults: slack.DefaultSearchMaxResults,
+		}).Return(&slack.SearchAllResult{
+			Messages: slack.SearchResult{Matches: []slack.Message{{Text: "deploy done"}}, Total: 1},
+			Files:    slack.SearchFilesResult{Matches: []slack.File{{ID: "F123"}}, Total: 1},
+		}, nil)
+
+		handler := makeSearchAll(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"query": "deploy",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("all", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().SearchAll(gomock.Any(), slack.SearchParams{
+			Query:      "deploy",
+			Sort:       "timestamp",
+			SortDir:    "desc",
+			Page:       1,
+			Pagination: slack.PaginationParams{Limit: 20, All: true},
+			MaxResults: slack.DefaultSearchMaxResults,
+		}).Return(&slack.SearchAllResult{}, nil)
+
+		handler := makeSearchAll(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"query": "deploy",
+			"all":   true,
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("resolve", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().SearchAll(gomock.Any(), gomock.Any()).Return(&slack.SearchAllResult{
+			Messages: slack.SearchResult{Matches: []slack.Message{{User: "U1", Text: "ask <@U2>"}}},
+		}, nil)
+		mock.EXPECT().GetUsers(gomock.Any(), []string{"U1", "U2"}).Return([]slack.User{
+			{ID: "U1", Name: "alice"}, {ID: "U2", Name: "bob"},
+		}, nil)
+
+		handler := makeSearchAll(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"query":   "deploy",
+			"resolve": true,
+		}))
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		text := result.Content[0].(mcp.TextContent).Text
+		assert.Contains(t, text, `"text": "ask @bob"`)
+		assert.Contains(t, text, `"user_name": "alice"`)
+	})
+
+	t.Run("missing query", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		handler := makeSearchAll(mock)
+		result, err := handler(context.Background(), newRequest(nil))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
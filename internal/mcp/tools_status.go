@@ -0,0 +1,111 @@
+package mcp
+
+import (
+	"context"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/jackchuka/slackcli/internal/slack"
+)
+
+func registerStatusTools(s *server.MCPServer, client slack.Service, readOnly bool) {
+	s.AddTool(mcp.NewTool("get_dnd_info",
+		mcp.WithDescription("Get a user's Do Not Disturb status"),
+		mcp.WithString("user_id", mcp.Description("User ID, @handle or email (defaults to authenticated user)")),
+	), makeGetDNDInfo(client))
+
+	if readOnly {
+		return
+	}
+
+	s.AddTool(mcp.NewTool("set_status",
+		mcp.WithDescription("Set the authenticated user's custom status; omit text and emoji to clear it"),
+		mcp.WithString("text", mcp.Description("Status text, e.g. \"deploying\"")),
+		mcp.WithString("emoji", mcp.Description("Status emoji name, e.g. rocket")),
+		mcp.WithString("expires", mcp.Description("When the status clears: RFC 3339 or relative like 30m, 2h or 1d (default never)")),
+	), makeSetStatus(client))
+
+	s.AddTool(mcp.NewTool("set_presence",
+		mcp.WithDescription("Set the authenticated user's presence"),
+		mcp.WithString("presence", mcp.Required(), mcp.Enum("auto", "away"), mcp.Description("auto or away")),
+	), makeSetPresence(client))
+
+	s.AddTool(mcp.NewTool("set_dnd_snooze",
+		mcp.WithDescription("Pause notifications for the authenticated user"),
+		mcp.WithNumber("minutes", mcp.Required(), mcp.Description("Snooze length in minutes")),
+	), makeSetDNDSnooze(client))
+
+	s.AddTool(mcp.NewTool("end_dnd_snooze",
+		mcp.WithDescription("End the authenticated user's current snooze"),
+	), makeEndDNDSnooze(client))
+}
+
+func makeGetDNDInfo(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		status, err := client.GetDNDInfo(ctx, request.GetString("user_id", ""))
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(status)), nil
+	}
+}
+
+func makeSetStatus(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		params := slack.SetStatusParams{
+			Text:  request.GetString("text", ""),
+			Emoji: request.GetString("emoji", ""),
+		}
+		if expires := request.GetString("expires", ""); expires != "" {
+			t, err := slack.ParseTime(expires, time.Now())
+			if err != nil {
+				return errResult(err), nil
+			}
+			params.Expiration = t
+		}
+		status, err := client.SetStatus(ctx, params)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(status)), nil
+	}
+}
+
+func makeSetPresence(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		presence, err := request.RequireString("presence")
+		if err != nil {
+			return errResult(err), nil
+		}
+		if err := client.SetPresence(ctx, presence); err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(map[string]string{"status": "updated", "presence": presence})), nil
+	}
+}
+
+func makeSetDNDSnooze(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		minutes, err := request.RequireInt("minutes")
+		if err != nil {
+			return errResult(err), nil
+		}
+		status, err := client.SetSnooze(ctx, time.Duration(minutes)*time.Minute)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(status)), nil
+	}
+}
+
+func makeEndDNDSnooze(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		status, err := client.EndSnooze(ctx)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(status)), nil
+	}
+}
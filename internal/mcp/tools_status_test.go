@@ -0,0 +1,118 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackchuka/slackcli/internal/slack"
+	"github.com/jackchuka/slackcli/internal/slack/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMakeGetDNDInfo(t *testing.T) {
+	t.Run("defaults to self", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().GetDNDInfo(gomock.Any(), "").Return(&slack.DNDStatus{Enabled: true}, nil)
+
+		handler := makeGetDNDInfo(mock)
+		result, err := handler(context.Background(), newRequest(nil))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestMakeSetStatus(t *testing.T) {
+	t.Run("with expiry", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().SetStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, params slack.SetStatusParams) (*slack.UserStatus, error) {
+				assert.Equal(t, "deploying", params.Text)
+				assert.Equal(t, "rocket", params.Emoji)
+				assert.WithinDuration(t, time.Now().Add(30*time.Minute), params.Expiration, time.Minute)
+				return &slack.UserStatus{Text: "deploying", Emoji: ":rocket:"}, nil
+			})
+
+		handler := makeSetStatus(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"text":    "deploying",
+			"emoji":   "rocket",
+			"expires": "30m",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("invalid expiry", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		handler := makeSetStatus(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"text":    "deploying",
+			"expires": "soon",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestMakeSetPresence(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().SetPresence(gomock.Any(), "away").Return(nil)
+
+		handler := makeSetPresence(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"presence": "away",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestMakeSetDNDSnooze(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().SetSnooze(gomock.Any(), 45*time.Minute).Return(&slack.DNDStatus{SnoozeEnabled: true}, nil)
+
+		handler := makeSetDNDSnooze(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"minutes": float64(45),
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestMakeEndDNDSnooze(t *testing.T) {
+	t.Run("not snoozing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().EndSnooze(gomock.Any()).Return(nil, &slack.SlackError{
+			Code: slack.ErrValidation, Message: "snooze_not_active",
+		})
+
+		handler := makeEndDNDSnooze(mock)
+		result, err := handler(context.Background(), newRequest(nil))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
@@ -0,0 +1,180 @@
+package mcp
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/jackchuka/slackcli/internal/slack"
+)
+
+func registerUserGroupTools(s *server.MCPServer, client slack.Service, readOnly bool) {
+	s.AddTool(mcp.NewTool("list_usergroups",
+		mcp.WithDescription("List user groups (e.g. @oncall) in the workspace"),
+		mcp.WithBoolean("include_disabled", mcp.Description("Include disabled user groups")),
+		mcp.WithBoolean("include_users", mcp.Description("Include member user IDs")),
+	), makeListUserGroups(client))
+
+	s.AddTool(mcp.NewTool("list_usergroup_members",
+		mcp.WithDescription("List the user IDs in a user group"),
+		mcp.WithString("usergroup_id", mcp.Required(), mcp.Description("User group ID")),
+	), makeListUserGroupMembers(client))
+
+	if readOnly {
+		return
+	}
+
+	s.AddTool(mcp.NewTool("create_usergroup",
+		mcp.WithDescription("Create a user group"),
+		mcp.WithString("name", mcp.Required(), mcp.Description("Name")),
+		mcp.WithString("handle", mcp.Description("Mention handle, without the @")),
+		mcp.WithString("description", mcp.Description("Description")),
+		mcp.WithArray("channels", mcp.Description("Default channels for members, by ID or #name")),
+	), makeCreateUserGroup(client))
+
+	s.AddTool(mcp.NewTool("update_usergroup",
+		mcp.WithDescription("Update a user group's name, handle, description or default channels; omitted fields are left unchanged"),
+		mcp.WithString("usergroup_id", mcp.Required(), mcp.Description("User group ID")),
+		mcp.WithString("name", mcp.Description("New name")),
+		mcp.WithString("handle", mcp.Description("New mention handle, without the @")),
+		mcp.WithString("description", mcp.Description("New description")),
+		mcp.WithArray("channels", mcp.Description("New default channels for members, by ID or #name")),
+	), makeUpdateUserGroup(client))
+
+	s.AddTool(mcp.NewTool("set_usergroup_members",
+		mcp.WithDescription("Replace the members of a user group"),
+		mcp.WithString("usergroup_id", mcp.Required(), mcp.Description("User group ID")),
+		mcp.WithArray("user_ids", mcp.Required(), mcp.Description("User IDs, @handles or emails that make up the group")),
+	), makeSetUserGroupMembers(client))
+
+	s.AddTool(mcp.NewTool("disable_usergroup",
+		mcp.WithDescription("Disable a user group"),
+		mcp.WithString("usergroup_id", mcp.Required(), mcp.Description("User group ID")),
+	), makeDisableUserGroup(client))
+
+	s.AddTool(mcp.NewTool("enable_usergroup",
+		mcp.WithDescription("Re-enable a disabled user group"),
+		mcp.WithString("usergroup_id", mcp.Required(), mcp.Description("User group ID")),
+	), makeEnableUserGroup(client))
+}
+
+func makeListUserGroups(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		groups, err := client.ListUserGroups(ctx, slack.ListUserGroupsParams{
+			IncludeDisabled: request.GetBool("include_disabled", false),
+			IncludeUsers:    request.GetBool("include_users", false),
+		})
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(groups)), nil
+	}
+}
+
+func makeListUserGroupMembers(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userGroupID, err := request.RequireString("usergroup_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		members, err := client.ListUserGroupMembers(ctx, userGroupID)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(members)), nil
+	}
+}
+
+func makeCreateUserGroup(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		name, err := request.RequireString("name")
+		if err != nil {
+			return errResult(err), nil
+		}
+		group, err := client.CreateUserGroup(ctx, slack.CreateUserGroupParams{
+			Name:        name,
+			Handle:      request.GetString("handle", ""),
+			Description: request.GetString("description", ""),
+			Channels:    request.GetStringSlice("channels", nil),
+		})
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(group)), nil
+	}
+}
+
+func makeUpdateUserGroup(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userGroupID, err := request.RequireString("usergroup_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		params := slack.UpdateUserGroupParams{
+			UserGroupID: userGroupID,
+			Name:        request.GetString("name", ""),
+			Handle:      request.GetString("handle", ""),
+		}
+		args := request.GetArguments()
+		if _, ok := args["description"]; ok {
+			description := request.GetString("description", "")
+			params.Description = &description
+		}
+		if _, ok := args["channels"]; ok {
+			params.Channels = request.GetStringSlice("channels", []string{})
+		}
+
+		group, err := client.UpdateUserGroup(ctx, params)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(group)), nil
+	}
+}
+
+func makeSetUserGroupMembers(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userGroupID, err := request.RequireString("usergroup_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		userIDs := request.GetStringSlice("user_ids", nil)
+		if len(userIDs) == 0 {
+			return mcp.NewToolResultError("user_ids is required"), nil
+		}
+		group, err := client.SetUserGroupMembers(ctx, userGroupID, userIDs)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(group)), nil
+	}
+}
+
+func makeDisableUserGroup(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userGroupID, err := request.RequireString("usergroup_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		group, err := client.DisableUserGroup(ctx, userGroupID)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(group)), nil
+	}
+}
+
+func makeEnableUserGroup(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userGroupID, err := request.RequireString("usergroup_id")
+		if err != nil {
+			return errResult(err), nil
+		}
+		group, err := client.EnableUserGroup(ctx, userGroupID)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(group)), nil
+	}
+}
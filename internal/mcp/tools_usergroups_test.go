@@ -0,0 +1,163 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackchuka/slackcli/internal/slack"
+	"github.com/jackchuka/slackcli/internal/slack/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMakeListUserGroups(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListUserGroups(gomock.Any(), slack.ListUserGroupsParams{IncludeUsers: true}).Return([]slack.UserGroup{
+			{ID: "S123", Name: "On-call", Handle: "oncall", UserCount: 2, Users: []string{"U1", "U2"}},
+		}, nil)
+
+		handler := makeListUserGroups(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"include_users": true,
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestMakeListUserGroupMembers(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().ListUserGroupMembers(gomock.Any(), "S404").Return(nil, &slack.SlackError{
+			Code: slack.ErrNotFound, Message: "no_such_subteam",
+		})
+
+		handler := makeListUserGroupMembers(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"usergroup_id": "S404",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestMakeCreateUserGroup(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().CreateUserGroup(gomock.Any(), slack.CreateUserGroupParams{
+			Name:     "On-call",
+			Handle:   "oncall",
+			Channels: []string{"C123"},
+		}).Return(&slack.UserGroup{ID: "S123", Name: "On-call", Handle: "oncall"}, nil)
+
+		handler := makeCreateUserGroup(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"name":     "On-call",
+			"handle":   "oncall",
+			"channels": []any{"C123"},
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestMakeUpdateUserGroup(t *testing.T) {
+	t.Run("only given fields", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		description := ""
+		mock.EXPECT().UpdateUserGroup(gomock.Any(), slack.UpdateUserGroupParams{
+			UserGroupID: "S123",
+			Handle:      "oncall-payments",
+			Description: &description,
+		}).Return(&slack.UserGroup{ID: "S123", Handle: "oncall-payments"}, nil)
+
+		handler := makeUpdateUserGroup(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"usergroup_id": "S123",
+			"handle":       "oncall-payments",
+			"description":  "",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestMakeSetUserGroupMembers(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().SetUserGroupMembers(gomock.Any(), "S123", []string{"U1", "U2"}).
+			Return(&slack.UserGroup{ID: "S123", UserCount: 2}, nil)
+
+		handler := makeSetUserGroupMembers(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"usergroup_id": "S123",
+			"user_ids":     []any{"U1", "U2"},
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("missing user_ids", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		handler := makeSetUserGroupMembers(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"usergroup_id": "S123",
+		}))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestMakeDisableUserGroup(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().DisableUserGroup(gomock.Any(), "S123").Return(&slack.UserGroup{ID: "S123", IsDisabled: true}, nil)
+
+		handler := makeDisableUserGroup(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"usergroup_id": "S123",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestMakeEnableUserGroup(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().EnableUserGroup(gomock.Any(), "S123").Return(&slack.UserGroup{ID: "S123"}, nil)
+
+		handler := makeEnableUserGroup(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"usergroup_id": "S123",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
@@ -18,13 +18,25 @@ func registerUserTools(s *server.MCPServer, client slack.Service) {
 
 	s.AddTool(mcp.NewTool("get_user_info",
 		mcp.WithDescription("Get information about a Slack user"),
-		mcp.WithString("user_id", mcp.Required(), mcp.Description("User ID")),
+		mcp.WithString("user_id", mcp.Required(), mcp.Description("User ID, @handle or email")),
 	), makeGetUserInfo(client))
 
 	s.AddTool(mcp.NewTool("get_user_presence",
 		mcp.WithDescription("Get a user's presence status"),
-		mcp.WithString("user_id", mcp.Required(), mcp.Description("User ID")),
+		mcp.WithString("user_id", mcp.Required(), mcp.Description("User ID, @handle or email")),
 	), makeGetUserPresence(client))
+
+	s.AddTool(mcp.NewTool("lookup_user_by_email",
+		mcp.WithDescription("Look up a Slack user by email address"),
+		mcp.WithString("email", mcp.Required(), mcp.Description("Email address")),
+	), makeLookupUserByEmail(client))
+
+	s.AddTool(mcp.NewTool("find_users",
+		mcp.WithDescription("Search users by handle, display name, real name, email or title, with fuzzy matching. Best matches come first"),
+		mcp.WithString("query", mcp.Required(), mcp.Description("Search text, e.g. jane, jane doe, jane@example.com or engineer")),
+		mcp.WithNumber("limit", mcp.Description("Max users to return"), mcp.DefaultNumber(slack.DefaultFindUsersLimit)),
+		mcp.WithBoolean("include_deleted", mcp.Description("Include deactivated users")),
+	), makeFindUsers(client))
 }
 
 func makeListUsers(client slack.Service) server.ToolHandlerFunc {
@@ -32,7 +44,7 @@ func makeListUsers(client slack.Service) server.ToolHandlerFunc {
 		limit := request.GetInt("limit", 100)
 		all := request.GetBool("all", false)
 
-		result, err := client.ListUsers(slack.PaginationParams{Limit: limit, All: all})
+		result, err := client.ListUsers(ctx, slack.PaginationParams{Limit: limit, All: all})
 		if err != nil {
 			return errResult(err), nil
 		}
@@ -46,7 +58,7 @@ func makeGetUserInfo(client slack.Service) server.ToolHandlerFunc {
 		if err != nil {
 			return errResult(err), nil
 		}
-		user, err := client.GetUserInfo(userID)
+		user, err := client.GetUserInfo(ctx, userID)
 		if err != nil {
 			return errResult(err), nil
 		}
@@ -60,10 +72,42 @@ func makeGetUserPresence(client slack.Service) server.ToolHandlerFunc {
 		if err != nil {
 			return errResult(err), nil
 		}
-		presence, err := client.GetUserPresence(userID)
+		presence, err := client.GetUserPresence(ctx, userID)
 		if err != nil {
 			return errResult(err), nil
 		}
 		return mcp.NewToolResultText(toJSON(map[string]string{"user_id": userID, "presence": presence})), nil
 	}
 }
+
+func makeLookupUserByEmail(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		email, err := request.RequireString("email")
+		if err != nil {
+			return errResult(err), nil
+		}
+		user, err := client.LookupUserByEmail(ctx, email)
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(user)), nil
+	}
+}
+
+func makeFindUsers(client slack.Service) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		query, err := request.RequireString("query")
+		if err != nil {
+			return errResult(err), nil
+		}
+		users, err := slack.FindUsers(ctx, client, slack.FindUsersParams{
+			Query:          query,
+			Limit:          request.GetInt("limit", slack.DefaultFindUsersLimit),
+			IncludeDeleted: request.GetBool("include_deleted", false),
+		})
+		if err != nil {
+			return errResult(err), nil
+		}
+		return mcp.NewToolResultText(toJSON(users)), nil
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/jackchuka/slackcli/internal/slack"
 	"github.com/jackchuka/slackcli/internal/slack/mocks"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -16,7 +17,7 @@ func TestMakeListUsers(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().ListUsers(slack.PaginationParams{
+		mock.EXPECT().ListUsers(gomock.Any(), slack.PaginationParams{
 			Limit: 100,
 			All:   false,
 		}).Return(&slack.PaginatedResult[slack.User]{
@@ -36,7 +37,7 @@ func TestMakeGetUserInfo(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().GetUserInfo("U123").Return(&slack.User{
+		mock.EXPECT().GetUserInfo(gomock.Any(), "U123").Return(&slack.User{
 			ID: "U123", Name: "alice", RealName: "Alice Smith",
 		}, nil)
 
@@ -66,7 +67,7 @@ func TestMakeGetUserPresence(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := mocks.NewMockService(ctrl)
 
-		mock.EXPECT().GetUserPresence("U123").Return("active", nil)
+		mock.EXPECT().GetUserPresence(gomock.Any(), "U123").Return("active", nil)
 
 		handler := makeGetUserPresence(mock)
 		result, err := handler(context.Background(), newRequest(map[string]any{
@@ -77,3 +78,74 @@ func TestMakeGetUserPresence(t *testing.T) {
 		assert.False(t, result.IsError)
 	})
 }
+
+func TestMakeLookupUserByEmail(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().LookupUserByEmail(gomock.Any(), "alice@example.com").Return(&slack.User{
+			ID: "U123", Name: "alice", Email: "alice@example.com",
+		}, nil)
+
+		handler := makeLookupUserByEmail(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"email": "alice@example.com",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, `"id": "U123"`)
+	})
+
+	t.Run("missing email", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		handler := makeLookupUserByEmail(mock)
+		result, err := handler(context.Background(), newRequest(nil))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestMakeFindUsers(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		mock.EXPECT().IterUsers(gomock.Any(), gomock.Any()).Return(func(yield func(slack.User, error) bool) {
+			for _, u := range []slack.User{
+				{ID: "U1", Name: "alice", RealName: "Alice Smith"},
+				{ID: "U2", Name: "bob", RealName: "Bob Jones", Title: "Engineer"},
+			} {
+				if !yield(u, nil) {
+					return
+				}
+			}
+		})
+
+		handler := makeFindUsers(mock)
+		result, err := handler(context.Background(), newRequest(map[string]any{
+			"query": "engineer",
+		}))
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		text := result.Content[0].(mcp.TextContent).Text
+		assert.Contains(t, text, `"id": "U2"`)
+		assert.NotContains(t, text, `"id": "U1"`)
+	})
+
+	t.Run("missing query", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := mocks.NewMockService(ctrl)
+
+		handler := makeFindUsers(mock)
+		result, err := handler(context.Background(), newRequest(nil))
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"os"
 	"reflect"
 	"sort"
@@ -19,6 +20,44 @@ type Formatter interface {
 	Format(data any) error
 }
 
+// StreamFormatter is implemented by formatters that can render a list as
+// its items arrive instead of after the whole list has been fetched.
+type StreamFormatter interface {
+	FormatStream(items iter.Seq2[any, error]) error
+}
+
+// Stream renders the items of seq in the same shape as a paginated result.
+// Formatters that implement StreamFormatter write each item as it arrives;
+// others receive the collected list once seq is exhausted.
+func Stream[T any](f Formatter, seq iter.Seq2[T, error]) error {
+	if sf, ok := f.(StreamFormatter); ok {
+		return sf.FormatStream(func(yield func(any, error) bool) {
+			for item, err := range seq {
+				if !yield(item, err) {
+					return
+				}
+			}
+		})
+	}
+
+	items := []T{}
+	for item, err := range seq {
+		if err != nil {
+			return err
+		}
+		items = append(items, item)
+	}
+	return f.Format(streamResult[T]{Items: items})
+}
+
+// streamResult mirrors the shape of slack.PaginatedResult for a fully
+// drained list.
+type streamResult[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+}
+
 // Writers holds the stdout and stderr writers.
 type Writers struct {
 	Out io.Writer
@@ -49,6 +88,47 @@ func (f *JSONFormatter) Format(data any) error {
 	return enc.Encode(data)
 }
 
+// FormatStream writes {"items": [...], "has_more": false}, flushing each
+// item to the writer as soon as it is received. If items fails partway, the
+// items so far are closed off with "has_more": true, so the output still
+// parses, and the error is returned.
+func (f *JSONFormatter) FormatStream(items iter.Seq2[any, error]) error {
+	if _, err := io.WriteString(f.w, "{\n  \"items\": ["); err != nil {
+		return err
+	}
+	n := 0
+	for item, err := range items {
+		if err != nil {
+			_ = f.closeStream(n, true)
+			return err
+		}
+		b, err := json.MarshalIndent(item, "    ", "  ")
+		if err != nil {
+			_ = f.closeStream(n, true)
+			return err
+		}
+		sep := ",\n    "
+		if n == 0 {
+			sep = "\n    "
+		}
+		if _, err := fmt.Fprintf(f.w, "%s%s", sep, b); err != nil {
+			return err
+		}
+		n++
+	}
+	return f.closeStream(n, false)
+}
+
+// closeStream ends the array FormatStream opened after n items.
+func (f *JSONFormatter) closeStream(n int, hasMore bool) error {
+	closing := fmt.Sprintf("\n  ],\n  \"has_more\": %t\n}\n", hasMore)
+	if n == 0 {
+		closing = fmt.Sprintf("],\n  \"has_more\": %t\n}\n", hasMore)
+	}
+	_, err := io.WriteString(f.w, closing)
+	return err
+}
+
 // TableFormatter outputs data as an ASCII table.
 type TableFormatter struct {
 	w io.Writer
@@ -127,14 +207,22 @@ func (f *TableFormatter) formatStruct(rv reflect.Value) error {
 	}
 
 	// Detect SearchResult: has Matches (slice) and Total (int)
-	matchesField := rv.FieldByName("Matches")
-	totalField := rv.FieldByName("Total")
-	if matchesField.IsValid() && matchesField.Kind() == reflect.Slice &&
-		totalField.IsValid() {
-		if err := f.formatSlice(matchesField); err != nil {
-			return err
+	if isSearchResult(rv) {
+		return f.formatSearchResult(rv)
+	}
+
+	// Detect grouped search results such as SearchAllResult: every field is
+	// a SearchResult, rendered as its own titled section.
+	if isSearchGroup(rv) {
+		for i := 0; i < rv.NumField(); i++ {
+			if i > 0 {
+				_, _ = fmt.Fprintln(f.w)
+			}
+			_, _ = fmt.Fprintf(f.w, "%s\n", rv.Type().Field(i).Name)
+			if err := f.formatSearchResult(rv.Field(i)); err != nil {
+				return err
+			}
 		}
-		_, _ = fmt.Fprintf(f.w, "\nTotal: %d\n", totalField.Int())
 		return nil
 	}
 
@@ -142,6 +230,34 @@ func (f *TableFormatter) formatStruct(rv reflect.Value) error {
 	return f.formatStructAsKeyValue(rv)
 }
 
+func isSearchResult(rv reflect.Value) bool {
+	matches := rv.FieldByName("Matches")
+	return matches.IsValid() && matches.Kind() == reflect.Slice && rv.FieldByName("Total").IsValid()
+}
+
+func isSearchGroup(rv reflect.Value) bool {
+	if rv.NumField() == 0 {
+		return false
+	}
+	for i := 0; i < rv.NumField(); i++ {
+		if !rv.Type().Field(i).IsExported() || rv.Field(i).Kind() != reflect.Struct || !isSearchResult(rv.Field(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *TableFormatter) formatSearchResult(rv reflect.Value) error {
+	if err := f.formatSlice(rv.FieldByName("Matches")); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(f.w, "\nTotal: %d\n", rv.FieldByName("Total").Int())
+	if page, pages := rv.FieldByName("Page"), rv.FieldByName("Pages"); page.IsValid() && pages.IsValid() && pages.Int() > 0 {
+		_, _ = fmt.Fprintf(f.w, "Page %d of %d\n", page.Int(), pages.Int())
+	}
+	return nil
+}
+
 func (f *TableFormatter) formatStructAsKeyValue(rv reflect.Value) error {
 	rt := rv.Type()
 	table := newKeyValueTable(f.w)
@@ -231,15 +347,22 @@ func fieldName(f reflect.StructField) (string, bool) {
 	return name, false
 }
 
+// columnField reports whether a field gets a column in a multi-column table.
+// Fields tagged table:"-" are detail that would crowd a list; they still
+// show when a single struct is rendered as key-value pairs.
+func columnField(f reflect.StructField) (string, bool) {
+	if !f.IsExported() || f.Tag.Get("table") == "-" {
+		return "", false
+	}
+	name, skip := fieldName(f)
+	return name, !skip
+}
+
 func structHeaders(rt reflect.Type) []string {
 	var headers []string
 	for i := 0; i < rt.NumField(); i++ {
-		field := rt.Field(i)
-		if !field.IsExported() {
-			continue
-		}
-		name, skip := fieldName(field)
-		if skip {
+		name, ok := columnField(rt.Field(i))
+		if !ok {
 			continue
 		}
 		headers = append(headers, name)
@@ -250,12 +373,7 @@ func structHeaders(rt reflect.Type) []string {
 func structValues(rv reflect.Value, rt reflect.Type) []string {
 	var vals []string
 	for i := 0; i < rt.NumField(); i++ {
-		field := rt.Field(i)
-		if !field.IsExported() {
-			continue
-		}
-		_, skip := fieldName(field)
-		if skip {
+		if _, ok := columnField(rt.Field(i)); !ok {
 			continue
 		}
 		vals = append(vals, formatValue(rv.Field(i).Interface()))
@@ -267,7 +385,13 @@ func formatValue(v any) string {
 	if v == nil {
 		return ""
 	}
+	if raw, ok := v.(json.RawMessage); ok {
+		return string(raw)
+	}
 	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return ""
+	}
 	if rv.Kind() == reflect.Slice {
 		parts := make([]string, rv.Len())
 		for i := 0; i < rv.Len(); i++ {
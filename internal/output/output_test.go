@@ -3,7 +3,10 @@ package output
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"iter"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -159,6 +162,75 @@ func TestTableFormatter_Format(t *testing.T) {
 		assert.Contains(t, out, "Total: 42")
 	})
 
+	t.Run("paged SearchResult shape", func(t *testing.T) {
+		type Match struct {
+			Text string `json:"text"`
+		}
+		type Paging struct {
+			Page  int `json:"page"`
+			Pages int `json:"pages"`
+		}
+		type SearchResult struct {
+			Matches []Match `json:"matches"`
+			Total   int     `json:"total"`
+			Paging
+		}
+
+		var buf bytes.Buffer
+		f := NewTableFormatter(&buf)
+		data := SearchResult{
+			Matches: []Match{{Text: "hello world"}},
+			Total:   42,
+			Paging:  Paging{Page: 2, Pages: 3},
+		}
+
+		require.NoError(t, f.Format(data))
+
+		out := buf.String()
+		assert.Contains(t, out, "Total: 42")
+		assert.Contains(t, out, "Page 2 of 3")
+	})
+
+	t.Run("grouped SearchResult shape renders a section per kind", func(t *testing.T) {
+		type Message struct {
+			Text string `json:"text"`
+		}
+		type File struct {
+			Name string `json:"name"`
+		}
+		type MessageResult struct {
+			Matches []Message `json:"matches"`
+			Total   int       `json:"total"`
+		}
+		type FileResult struct {
+			Matches []File `json:"matches"`
+			Total   int    `json:"total"`
+		}
+		type AllResult struct {
+			Messages MessageResult `json:"messages"`
+			Files    FileResult    `json:"files"`
+		}
+
+		var buf bytes.Buffer
+		f := NewTableFormatter(&buf)
+		data := AllResult{
+			Messages: MessageResult{Matches: []Message{{Text: "hello world"}}, Total: 7},
+			Files:    FileResult{Matches: []File{{Name: "report.pdf"}}, Total: 3},
+		}
+
+		require.NoError(t, f.Format(data))
+
+		out := buf.String()
+		assert.Contains(t, out, "Messages\n")
+		assert.Contains(t, out, "hello world")
+		assert.Contains(t, out, "Total: 7")
+		assert.Contains(t, out, "Files\n")
+		assert.Contains(t, out, "report.pdf")
+		assert.Contains(t, out, "Total: 3")
+		assert.Less(t, strings.Index(out, "Total: 7"), strings.Index(out, "Files\n"))
+		assert.NotContains(t, out, "{", "sections are tables, not struct dumps")
+	})
+
 	t.Run("single struct renders as key-value", func(t *testing.T) {
 		type Info struct {
 			Name string `json:"name"`
@@ -234,6 +306,24 @@ func TestTableFormatter_Format(t *testing.T) {
 		assert.NotContains(t, out, "Hidden")
 		assert.NotContains(t, out, "no")
 	})
+
+	t.Run("table tag '-' drops list column but keeps key-value field", func(t *testing.T) {
+		type Row struct {
+			ID     string `json:"id"`
+			Detail string `json:"detail" table:"-"`
+		}
+
+		var list bytes.Buffer
+		require.NoError(t, NewTableFormatter(&list).Format([]Row{{ID: "1", Detail: "verbose"}}))
+		assert.Contains(t, list.String(), "ID")
+		assert.NotContains(t, list.String(), "DETAIL")
+		assert.NotContains(t, list.String(), "verbose")
+
+		var single bytes.Buffer
+		require.NoError(t, NewTableFormatter(&single).Format(Row{ID: "1", Detail: "verbose"}))
+		assert.Contains(t, single.String(), "detail")
+		assert.Contains(t, single.String(), "verbose")
+	})
 }
 
 func TestPrintError(t *testing.T) {
@@ -300,6 +390,8 @@ func TestFormatValue(t *testing.T) {
 		{"string slice", []string{"a", "b", "c"}, "a, b, c"},
 		{"int slice", []int{1, 2, 3}, "1, 2, 3"},
 		{"empty slice", []string{}, ""},
+		{"nil pointer", (*int)(nil), ""},
+		{"raw JSON", json.RawMessage(`[{"type":"divider"}]`), `[{"type":"divider"}]`},
 	}
 
 	for _, tt := range tests {
@@ -321,3 +413,95 @@ func TestSortedKeys(t *testing.T) {
 		assert.Empty(t, got)
 	})
 }
+
+func TestStream(t *testing.T) {
+	type Item struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	items := []Item{{ID: "1", Name: "first"}, {ID: "2", Name: "second"}}
+	seqOf := func(items []Item, err error) iter.Seq2[Item, error] {
+		return func(yield func(Item, error) bool) {
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if err != nil {
+				yield(Item{}, err)
+			}
+		}
+	}
+
+	t.Run("JSON matches the buffered paginated shape", func(t *testing.T) {
+		var streamed, buffered bytes.Buffer
+
+		require.NoError(t, Stream(NewJSONFormatter(&streamed), seqOf(items, nil)))
+		require.NoError(t, NewJSONFormatter(&buffered).Format(streamResult[Item]{Items: items}))
+
+		assert.Equal(t, buffered.String(), streamed.String())
+	})
+
+	t.Run("JSON with no items is valid", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		require.NoError(t, Stream(NewJSONFormatter(&buf), seqOf(nil, nil)))
+
+		var result struct {
+			Items   []Item `json:"items"`
+			HasMore bool   `json:"has_more"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+		assert.Empty(t, result.Items)
+	})
+
+	t.Run("JSON writes items before a later error", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		err := Stream(NewJSONFormatter(&buf), seqOf(items[:1], errors.New("boom")))
+
+		require.EqualError(t, err, "boom")
+		var result struct {
+			Items   []Item `json:"items"`
+			HasMore bool   `json:"has_more"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &result), "partial output must still parse")
+		assert.Equal(t, items[:1], result.Items)
+		assert.True(t, result.HasMore)
+	})
+
+	t.Run("JSON error before any item is valid", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		err := Stream(NewJSONFormatter(&buf), seqOf(nil, errors.New("boom")))
+
+		require.EqualError(t, err, "boom")
+		var result struct {
+			Items   []Item `json:"items"`
+			HasMore bool   `json:"has_more"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+		assert.Empty(t, result.Items)
+		assert.True(t, result.HasMore)
+	})
+
+	t.Run("table collects then renders", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		require.NoError(t, Stream(NewTableFormatter(&buf), seqOf(items, nil)))
+
+		out := buf.String()
+		assert.Contains(t, out, "first")
+		assert.Contains(t, out, "second")
+		assert.NotContains(t, out, "More results available")
+	})
+
+	t.Run("table returns error without rendering", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		err := Stream(NewTableFormatter(&buf), seqOf(items, errors.New("boom")))
+
+		require.EqualError(t, err, "boom")
+		assert.Empty(t, buf.String())
+	})
+}
@@ -1,5 +1,7 @@
 package slack
 
+import "context"
+
 type AuthTestResult struct {
 	UserID string `json:"user_id"`
 	User   string `json:"user"`
@@ -8,9 +10,9 @@ type AuthTestResult struct {
 	URL    string `json:"url"`
 }
 
-func (c *Client) AuthTest() (*AuthTestResult, error) {
-	resp, err := retry(func() (*AuthTestResult, error) {
-		r, err := c.api.AuthTest()
+func (c *Client) AuthTest(ctx context.Context) (*AuthTestResult, error) {
+	resp, err := retry(ctx, c.endpoint("auth.test"), func() (*AuthTestResult, error) {
+		r, err := c.api.AuthTestContext(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,283 @@
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	slackapi "github.com/slack-go/slack"
+)
+
+// Block Kit limits for messages.
+// See https://api.slack.com/reference/block-kit/blocks.
+const (
+	maxBlocks          = 50
+	maxBlockIDLen      = 255
+	maxSectionTextLen  = 3000
+	maxSectionFields   = 10
+	maxFieldTextLen    = 2000
+	maxHeaderTextLen   = 150
+	maxAltTextLen      = 2000
+	maxActionsElements = 25
+	maxContextElements = 10
+)
+
+// ValidateBlocks checks Block Kit JSON against the structural rules Slack
+// applies to messages, so mistakes are reported with their location rather
+// than as a bare invalid_blocks from the API. raw may be an array of blocks
+// or an object with a "blocks" array, as exported by Block Kit Builder.
+func ValidateBlocks(raw json.RawMessage) error {
+	_, err := parseBlocks(raw)
+	return err
+}
+
+// rawBlock passes a validated block through to Slack verbatim, so fields
+// slack-go does not model are not lost.
+type rawBlock struct {
+	blockType string
+	blockID   string
+	raw       json.RawMessage
+}
+
+func (b rawBlock) BlockType() slackapi.MessageBlockType {
+	return slackapi.MessageBlockType(b.blockType)
+}
+
+func (b rawBlock) ID() string {
+	return b.blockID
+}
+
+func (b rawBlock) MarshalJSON() ([]byte, error) {
+	return b.raw, nil
+}
+
+func parseBlocks(raw json.RawMessage) ([]slackapi.Block, error) {
+	items, err := unwrapArray(raw, "blocks")
+	if err != nil {
+		return nil, blocksError(err.Error())
+	}
+	if len(items) > maxBlocks {
+		return nil, blocksError(fmt.Sprintf("%d blocks given, at most %d are allowed", len(items), maxBlocks))
+	}
+
+	var problems []string
+	seen := make(map[string]int)
+	blocks := make([]slackapi.Block, 0, len(items))
+	for i, item := range items {
+		path := fmt.Sprintf("blocks[%d]", i)
+		var b map[string]any
+		if err := json.Unmarshal(item, &b); err != nil || b == nil {
+			problems = append(problems, path+": must be an object")
+			continue
+		}
+		typ, _ := b["type"].(string)
+		if typ == "" {
+			problems = append(problems, path+`: "type" is required`)
+			continue
+		}
+		path = fmt.Sprintf("%s (%s)", path, typ)
+
+		id, _ := b["block_id"].(string)
+		if id != "" {
+			if len(id) > maxBlockIDLen {
+				problems = append(problems, fmt.Sprintf("%s: block_id exceeds %d characters", path, maxBlockIDLen))
+			}
+			if j, ok := seen[id]; ok {
+				problems = append(problems, fmt.Sprintf("%s: block_id %q duplicates blocks[%d]", path, id, j))
+			}
+			seen[id] = i
+		}
+
+		problems = append(problems, validateBlock(path, typ, b)...)
+		blocks = append(blocks, rawBlock{blockType: typ, blockID: id, raw: item})
+	}
+	if len(problems) > 0 {
+		return nil, blocksError(strings.Join(problems, "; "))
+	}
+	return blocks, nil
+}
+
+func validateBlock(path, typ string, b map[string]any) []string {
+	var problems []string
+	switch typ {
+	case "section":
+		_, hasText := b["text"]
+		fields, hasFields := b["fields"]
+		if !hasText && !hasFields {
+			problems = append(problems, path+`: "text" or "fields" is required`)
+		}
+		if hasText {
+			problems = append(problems, validateTextObject(path+".text", b["text"], false, maxSectionTextLen)...)
+		}
+		if hasFields {
+			list, ok := fields.([]any)
+			switch {
+			case !ok:
+				problems = append(problems, path+`.fields: must be an array`)
+			case len(list) > maxSectionFields:
+				problems = append(problems, fmt.Sprintf("%s.fields: at most %d fields are allowed", path, maxSectionFields))
+			default:
+				for i, f := range list {
+					problems = append(problems, validateTextObject(fmt.Sprintf("%s.fields[%d]", path, i), f, false, maxFieldTextLen)...)
+				}
+			}
+		}
+	case "header":
+		problems = append(problems, validateTextObject(path+".text", b["text"], true, maxHeaderTextLen)...)
+	case "image":
+		if !hasString(b, "image_url") && b["slack_file"] == nil {
+			problems = append(problems, path+`: "image_url" or "slack_file" is required`)
+		}
+		problems = append(problems, requireString(path, b, "alt_text", maxAltTextLen)...)
+	case "actions":
+		problems = append(problems, validateElements(path, b, maxActionsElements)...)
+	case "context":
+		problems = append(problems, validateElements(path, b, maxContextElements)...)
+	case "input":
+		problems = append(problems, validateTextObject(path+".label", b["label"], true, maxFieldTextLen)...)
+		if _, ok := b["element"].(map[string]any); !ok {
+			problems = append(problems, path+`: "element" is required`)
+		}
+	case "video":
+		problems = append(problems, requireString(path, b, "alt_text", 0)...)
+		problems = append(problems, requireString(path, b, "thumbnail_url", 0)...)
+		problems = append(problems, requireString(path, b, "video_url", 0)...)
+		problems = append(problems, validateTextObject(path+".title", b["title"], true, 0)...)
+	case "rich_text":
+		problems = append(problems, validateElements(path, b, 0)...)
+	case "markdown":
+		problems = append(problems, requireString(path, b, "text", 0)...)
+	case "file":
+		problems = append(problems, requireString(path, b, "external_id", 0)...)
+		problems = append(problems, requireString(path, b, "source", 0)...)
+	case "table":
+		if _, ok := b["rows"].([]any); !ok {
+			problems = append(problems, path+`: "rows" is required`)
+		}
+	case "divider":
+	default:
+		problems = append(problems, path+": unknown block type")
+	}
+	return problems
+}
+
+// validateTextObject checks a composition text object. maxLen of 0 means the
+// length is not checked here.
+func validateTextObject(path string, v any, plainOnly bool, maxLen int) []string {
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return []string{path + ": text object is required"}
+	}
+	var problems []string
+	switch typ, _ := obj["type"].(string); typ {
+	case "plain_text":
+	case "mrkdwn":
+		if plainOnly {
+			problems = append(problems, path+`: type must be "plain_text"`)
+		}
+	default:
+		problems = append(problems, path+`: type must be "plain_text" or "mrkdwn"`)
+	}
+	return append(problems, requireString(path, obj, "text", maxLen)...)
+}
+
+func validateElements(path string, b map[string]any, maxLen int) []string {
+	list, ok := b["elements"].([]any)
+	if !ok || len(list) == 0 {
+		return []string{path + `: "elements" must be a non-empty array`}
+	}
+	var problems []string
+	if maxLen > 0 && len(list) > maxLen {
+		problems = append(problems, fmt.Sprintf("%s.elements: at most %d elements are allowed", path, maxLen))
+	}
+	for i, e := range list {
+		el, ok := e.(map[string]any)
+		if !ok || !hasString(el, "type") {
+			problems = append(problems, fmt.Sprintf(`%s.elements[%d]: "type" is required`, path, i))
+		}
+	}
+	return problems
+}
+
+func requireString(path string, obj map[string]any, key string, maxLen int) []string {
+	s, _ := obj[key].(string)
+	if s == "" {
+		return []string{fmt.Sprintf("%s: %q is required", path, key)}
+	}
+	if maxLen > 0 && len([]rune(s)) > maxLen {
+		return []string{fmt.Sprintf("%s.%s: exceeds %d characters", path, key, maxLen)}
+	}
+	return nil
+}
+
+func hasString(obj map[string]any, key string) bool {
+	s, _ := obj[key].(string)
+	return s != ""
+}
+
+func blocksError(detail string) *SlackError {
+	return &SlackError{Code: ErrValidation, Message: "invalid_blocks", Detail: detail}
+}
+
+func parseAttachments(raw json.RawMessage) ([]slackapi.Attachment, error) {
+	items, err := unwrapArray(raw, "attachments")
+	if err != nil {
+		return nil, &SlackError{Code: ErrValidation, Message: "invalid_attachments", Detail: err.Error()}
+	}
+	attachments := make([]slackapi.Attachment, len(items))
+	for i, item := range items {
+		if err := json.Unmarshal(item, &attachments[i]); err != nil {
+			return nil, &SlackError{
+				Code:    ErrValidation,
+				Message: "invalid_attachments",
+				Detail:  fmt.Sprintf("attachments[%d]: %v", i, err),
+			}
+		}
+	}
+	return attachments, nil
+}
+
+// unwrapArray decodes raw as a JSON array, or as an object holding the array
+// under key.
+func unwrapArray(raw json.RawMessage, key string) ([]json.RawMessage, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) > 0 && raw[0] == '{' {
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %v", err)
+		}
+		inner, ok := obj[key]
+		if !ok {
+			return nil, fmt.Errorf("expected an array or an object with a %q array", key)
+		}
+		raw = inner
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, fmt.Errorf("expected an array or an object with a %q array: %v", key, err)
+	}
+	return items, nil
+}
+
+// messageOptions builds the content options shared by the chat.* methods.
+func messageOptions(text string, blocks, attachments json.RawMessage) ([]slackapi.MsgOption, error) {
+	opts := []slackapi.MsgOption{
+		slackapi.MsgOptionText(text, false),
+	}
+	if len(blocks) > 0 {
+		parsed, err := parseBlocks(blocks)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, slackapi.MsgOptionBlocks(parsed...))
+	}
+	if len(attachments) > 0 {
+		parsed, err := parseAttachments(attachments)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, slackapi.MsgOptionAttachments(parsed...))
+	}
+	return opts, nil
+}
@@ -0,0 +1,99 @@
+package slack
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBlocks_Valid(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"empty", `[]`},
+		{"section text", `[{"type":"section","text":{"type":"mrkdwn","text":"*hi*"}}]`},
+		{"section fields", `[{"type":"section","fields":[{"type":"plain_text","text":"a"}]}]`},
+		{"header", `[{"type":"header","text":{"type":"plain_text","text":"Status"}}]`},
+		{"divider", `[{"type":"divider"}]`},
+		{"image", `[{"type":"image","image_url":"https://example.com/a.png","alt_text":"a"}]`},
+		{"actions", `[{"type":"actions","elements":[{"type":"button","text":{"type":"plain_text","text":"Go"}}]}]`},
+		{"context", `[{"type":"context","elements":[{"type":"mrkdwn","text":"note"}]}]`},
+		{"builder export", `{"blocks":[{"type":"divider"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, ValidateBlocks(json.RawMessage(tt.json)))
+		})
+	}
+}
+
+func TestValidateBlocks_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{"not an array", `"hello"`, "expected an array"},
+		{"object without blocks", `{"type":"divider"}`, `object with a "blocks" array`},
+		{"missing type", `[{"text":"hi"}]`, `blocks[0]: "type" is required`},
+		{"unknown type", `[{"type":"banner"}]`, "blocks[0] (banner): unknown block type"},
+		{"section without content", `[{"type":"section"}]`, `"text" or "fields" is required`},
+		{"section bad text type", `[{"type":"section","text":{"type":"html","text":"x"}}]`, `blocks[0] (section).text: type must be "plain_text" or "mrkdwn"`},
+		{"header mrkdwn", `[{"type":"header","text":{"type":"mrkdwn","text":"x"}}]`, `type must be "plain_text"`},
+		{"header too long", `[{"type":"header","text":{"type":"plain_text","text":"` + strings.Repeat("x", 151) + `"}}]`, "exceeds 150 characters"},
+		{"image without alt text", `[{"type":"image","image_url":"https://example.com/a.png"}]`, `"alt_text" is required`},
+		{"empty actions", `[{"type":"actions","elements":[]}]`, `"elements" must be a non-empty array`},
+		{"duplicate block_id", `[{"type":"divider","block_id":"a"},{"type":"divider","block_id":"a"}]`, `blocks[1] (divider): block_id "a" duplicates blocks[0]`},
+		{"too many blocks", "[" + strings.Repeat(`{"type":"divider"},`, 50) + `{"type":"divider"}]`, "51 blocks given, at most 50"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBlocks(json.RawMessage(tt.json))
+
+			var se *SlackError
+			require.ErrorAs(t, err, &se)
+			assert.Equal(t, ErrValidation, se.Code)
+			assert.Equal(t, "invalid_blocks", se.Message)
+			assert.Contains(t, se.Detail, tt.want)
+		})
+	}
+}
+
+func TestValidateBlocks_ReportsAllProblems(t *testing.T) {
+	err := ValidateBlocks(json.RawMessage(`[{"type":"section"},{"type":"image"}]`))
+
+	var se *SlackError
+	require.ErrorAs(t, err, &se)
+	assert.Contains(t, se.Detail, "blocks[0] (section)")
+	assert.Contains(t, se.Detail, "blocks[1] (image)")
+}
+
+func TestParseBlocks_PassesThroughVerbatim(t *testing.T) {
+	raw := `[{"type":"section","text":{"type":"mrkdwn","text":"hi"},"expand":true}]`
+
+	blocks, err := parseBlocks(json.RawMessage(raw))
+	require.NoError(t, err)
+
+	out, err := json.Marshal(blocks)
+	require.NoError(t, err)
+	assert.JSONEq(t, raw, string(out))
+}
+
+func TestParseAttachments(t *testing.T) {
+	attachments, err := parseAttachments(json.RawMessage(`[{"color":"good","text":"deployed"}]`))
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	assert.Equal(t, "good", attachments[0].Color)
+	assert.Equal(t, "deployed", attachments[0].Text)
+
+	_, err = parseAttachments(json.RawMessage(`"nope"`))
+	var se *SlackError
+	require.ErrorAs(t, err, &se)
+	assert.Equal(t, "invalid_attachments", se.Message)
+}
@@ -0,0 +1,107 @@
+package slack
+
+import (
+	"context"
+
+	slackapi "github.com/slack-go/slack"
+)
+
+type Bookmark struct {
+	ID        string `json:"id"`
+	ChannelID string `json:"channel_id"`
+	Title     string `json:"title"`
+	Link      string `json:"link"`
+	Emoji     string `json:"emoji,omitempty"`
+	Type      string `json:"type"`
+	Created   int64  `json:"created"`
+	Updated   int64  `json:"updated,omitempty"`
+}
+
+func bookmarkFromAPI(b slackapi.Bookmark) Bookmark {
+	return Bookmark{
+		ID:        b.ID,
+		ChannelID: b.ChannelID,
+		Title:     b.Title,
+		Link:      b.Link,
+		Emoji:     b.Emoji,
+		Type:      b.Type,
+		Created:   int64(b.Created),
+		Updated:   int64(b.Updated),
+	}
+}
+
+// ListBookmarks returns the bookmarks in a channel's bookmark bar.
+// bookmarks.list is not paginated.
+func (c *Client) ListBookmarks(ctx context.Context, channelID string) ([]Bookmark, error) {
+	bookmarks, err := retry(ctx, c.endpoint("bookmarks.list"), func() ([]slackapi.Bookmark, error) {
+		return c.api.ListBookmarksContext(ctx, channelID)
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+
+	result := make([]Bookmark, len(bookmarks))
+	for i, b := range bookmarks {
+		result[i] = bookmarkFromAPI(b)
+	}
+	return result, nil
+}
+
+type AddBookmarkParams struct {
+	ChannelID string
+	Title     string
+	Link      string
+	Emoji     string
+}
+
+// AddBookmark adds a link bookmark to a channel.
+func (c *Client) AddBookmark(ctx context.Context, params AddBookmarkParams) (*Bookmark, error) {
+	b, err := retry(ctx, c.writeEndpoint("bookmarks.add"), func() (slackapi.Bookmark, error) {
+		return c.api.AddBookmarkContext(ctx, params.ChannelID, slackapi.AddBookmarkParameters{
+			Title: params.Title,
+			Type:  "link",
+			Link:  params.Link,
+			Emoji: params.Emoji,
+		})
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	bookmark := bookmarkFromAPI(b)
+	return &bookmark, nil
+}
+
+// EditBookmarkParams changes a bookmark. Nil Title and Emoji, and an empty
+// Link, leave the current value; an empty Title or Emoji clears it.
+type EditBookmarkParams struct {
+	ChannelID  string
+	BookmarkID string
+	Title      *string
+	Link       string
+	Emoji      *string
+}
+
+func (c *Client) EditBookmark(ctx context.Context, params EditBookmarkParams) (*Bookmark, error) {
+	b, err := retry(ctx, c.writeEndpoint("bookmarks.edit"), func() (slackapi.Bookmark, error) {
+		return c.api.EditBookmarkContext(ctx, params.ChannelID, params.BookmarkID, slackapi.EditBookmarkParameters{
+			Title: params.Title,
+			Link:  params.Link,
+			Emoji: params.Emoji,
+		})
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	bookmark := bookmarkFromAPI(b)
+	return &bookmark, nil
+}
+
+func (c *Client) RemoveBookmark(ctx context.Context, channelID, bookmarkID string) error {
+	_, err := retry(ctx, c.writeEndpoint("bookmarks.remove"), func() (struct{}, error) {
+		return struct{}{}, c.api.RemoveBookmarkContext(ctx, channelID, bookmarkID)
+	})
+	if err != nil {
+		return classifyError(err)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package slack
+
+import (
+	"testing"
+
+	slackapi "github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookmarkFromAPI(t *testing.T) {
+	got := bookmarkFromAPI(slackapi.Bookmark{
+		ID:        "Bk123",
+		ChannelID: "C123",
+		Title:     "Payments dashboard",
+		Link:      "https://grafana.example.com/d/payments",
+		Emoji:     ":chart_with_upwards_trend:",
+		Type:      "link",
+		Created:   1700000000,
+		Updated:   1700000100,
+	})
+
+	assert.Equal(t, Bookmark{
+		ID:        "Bk123",
+		ChannelID: "C123",
+		Title:     "Payments dashboard",
+		Link:      "https://grafana.example.com/d/payments",
+		Emoji:     ":chart_with_upwards_trend:",
+		Type:      "link",
+		Created:   1700000000,
+		Updated:   1700000100,
+	}, got)
+}
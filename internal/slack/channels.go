@@ -1,6 +1,10 @@
 package slack
 
 import (
+	"context"
+	"iter"
+	"slices"
+
 	slackapi "github.com/slack-go/slack"
 )
 
@@ -13,6 +17,9 @@ type Channel struct {
 	IsArchived bool   `json:"is_archived"`
 	IsPrivate  bool   `json:"is_private"`
 	IsMember   bool   `json:"is_member"`
+	IsIM       bool   `json:"is_im,omitempty"`
+	IsMpIM     bool   `json:"is_mpim,omitempty"`
+	User       string `json:"user,omitempty"`
 	Created    int    `json:"created"`
 }
 
@@ -26,28 +33,49 @@ func channelFromAPI(ch slackapi.Channel) Channel {
 		IsArchived: ch.IsArchived,
 		IsPrivate:  ch.IsPrivate,
 		IsMember:   ch.IsMember,
+		IsIM:       ch.IsIM,
+		IsMpIM:     ch.IsMpIM,
+		User:       ch.User,
 		Created:    int(ch.Created),
 	}
 }
 
-func (c *Client) ListChannels(params PaginationParams) (*PaginatedResult[Channel], error) {
-	if params.All {
-		return c.listAllChannels(params)
+// DefaultChannelTypes are the conversation types listed when
+// ListChannelsParams.Types is empty.
+var DefaultChannelTypes = []string{"public_channel", "private_channel"}
+
+type ListChannelsParams struct {
+	// Types filters by conversation type: public_channel, private_channel,
+	// mpim and im.
+	Types      []string
+	Pagination PaginationParams
+}
+
+func (p ListChannelsParams) effectiveTypes() []string {
+	if len(p.Types) > 0 {
+		return p.Types
+	}
+	return DefaultChannelTypes
+}
+
+func (c *Client) ListChannels(ctx context.Context, params ListChannelsParams) (*PaginatedResult[Channel], error) {
+	if params.Pagination.All {
+		return collect(c.IterChannels(ctx, params))
 	}
-	return c.listChannelsPage(params)
+	return c.listChannelsPage(ctx, params)
 }
 
-func (c *Client) listChannelsPage(params PaginationParams) (*PaginatedResult[Channel], error) {
+func (c *Client) listChannelsPage(ctx context.Context, params ListChannelsParams) (*PaginatedResult[Channel], error) {
 	type result struct {
 		channels []slackapi.Channel
 		cursor   string
 	}
-	r, err := retry(func() (result, error) {
-		channels, cursor, err := c.api.GetConversations(&slackapi.GetConversationsParameters{
-			Cursor:          params.Cursor,
-			Limit:           params.EffectiveLimit(),
+	r, err := retry(ctx, c.endpoint("conversations.list"), func() (result, error) {
+		channels, cursor, err := c.api.GetConversationsContext(ctx, &slackapi.GetConversationsParameters{
+			Cursor:          params.Pagination.Cursor,
+			Limit:           params.Pagination.EffectiveLimit(),
 			ExcludeArchived: false,
-			Types:           []string{"public_channel", "private_channel"},
+			Types:           params.effectiveTypes(),
 		})
 		return result{channels, cursor}, err
 	})
@@ -65,32 +93,19 @@ func (c *Client) listChannelsPage(params PaginationParams) (*PaginatedResult[Cha
 	}, nil
 }
 
-func (c *Client) listAllChannels(params PaginationParams) (*PaginatedResult[Channel], error) {
-	var allItems []Channel
-	cursor := params.Cursor
-	for {
-		page, err := c.listChannelsPage(PaginationParams{
-			Cursor: cursor,
-			Limit:  params.EffectiveLimit(),
-		})
-		if err != nil {
-			return nil, err
-		}
-		allItems = append(allItems, page.Items...)
-		if !page.HasMore {
-			break
-		}
-		cursor = page.NextCursor
-	}
-	return &PaginatedResult[Channel]{
-		Items:   allItems,
-		HasMore: false,
-	}, nil
+// IterChannels yields channels page by page as they are fetched, starting at
+// params.Pagination.Cursor.
+func (c *Client) IterChannels(ctx context.Context, params ListChannelsParams) iter.Seq2[Channel, error] {
+	return paginate(params.Pagination.Cursor, func(cursor string) (*PaginatedResult[Channel], error) {
+		p := params
+		p.Pagination = PaginationParams{Cursor: cursor, Limit: params.Pagination.EffectiveLimit()}
+		return c.listChannelsPage(ctx, p)
+	})
 }
 
-func (c *Client) GetChannelInfo(channelID string) (*Channel, error) {
-	ch, err := retry(func() (*slackapi.Channel, error) {
-		return c.api.GetConversationInfo(&slackapi.GetConversationInfoInput{
+func (c *Client) GetChannelInfo(ctx context.Context, channelID string) (*Channel, error) {
+	ch, err := retry(ctx, c.endpoint("conversations.info"), func() (*slackapi.Channel, error) {
+		return c.api.GetConversationInfoContext(ctx, &slackapi.GetConversationInfoInput{
 			ChannelID: channelID,
 		})
 	})
@@ -101,9 +116,55 @@ func (c *Client) GetChannelInfo(channelID string) (*Channel, error) {
 	return &result, nil
 }
 
-func (c *Client) CreateChannel(name string, isPrivate bool) (*Channel, error) {
-	ch, err := retry(func() (*slackapi.Channel, error) {
-		return c.api.CreateConversation(slackapi.CreateConversationParams{
+type ListChannelMembersParams struct {
+	ChannelID  string
+	Pagination PaginationParams
+}
+
+// ListChannelMembers returns the IDs of a conversation's members.
+func (c *Client) ListChannelMembers(ctx context.Context, params ListChannelMembersParams) (*PaginatedResult[string], error) {
+	if params.Pagination.All {
+		return collect(c.IterChannelMembers(ctx, params))
+	}
+	return c.listChannelMembersPage(ctx, params)
+}
+
+func (c *Client) listChannelMembersPage(ctx context.Context, params ListChannelMembersParams) (*PaginatedResult[string], error) {
+	type result struct {
+		members []string
+		cursor  string
+	}
+	r, err := retry(ctx, c.endpoint("conversations.members"), func() (result, error) {
+		members, cursor, err := c.api.GetUsersInConversationContext(ctx, &slackapi.GetUsersInConversationParameters{
+			ChannelID: params.ChannelID,
+			Cursor:    params.Pagination.Cursor,
+			Limit:     params.Pagination.EffectiveLimit(),
+		})
+		return result{members, cursor}, err
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	return &PaginatedResult[string]{
+		Items:      r.members,
+		NextCursor: r.cursor,
+		HasMore:    r.cursor != "",
+	}, nil
+}
+
+// IterChannelMembers yields member IDs page by page as they are fetched,
+// starting at params.Pagination.Cursor.
+func (c *Client) IterChannelMembers(ctx context.Context, params ListChannelMembersParams) iter.Seq2[string, error] {
+	return paginate(params.Pagination.Cursor, func(cursor string) (*PaginatedResult[string], error) {
+		p := params
+		p.Pagination = PaginationParams{Cursor: cursor, Limit: params.Pagination.EffectiveLimit()}
+		return c.listChannelMembersPage(ctx, p)
+	})
+}
+
+func (c *Client) CreateChannel(ctx context.Context, name string, isPrivate bool) (*Channel, error) {
+	ch, err := retry(ctx, c.writeEndpoint("conversations.create"), func() (*slackapi.Channel, error) {
+		return c.api.CreateConversationContext(ctx, slackapi.CreateConversationParams{
 			ChannelName: name,
 			IsPrivate:   isPrivate,
 		})
@@ -115,9 +176,81 @@ func (c *Client) CreateChannel(name string, isPrivate bool) (*Channel, error) {
 	return &result, nil
 }
 
-func (c *Client) ArchiveChannel(channelID string) error {
-	_, err := retry(func() (struct{}, error) {
-		return struct{}{}, c.api.ArchiveConversation(channelID)
+// OpenConversation opens, or returns the existing, direct message with one
+// user or group direct message with several users.
+func (c *Client) OpenConversation(ctx context.Context, userIDs ...string) (*Channel, error) {
+	ch, err := retry(ctx, c.writeEndpoint("conversations.open"), func() (*slackapi.Channel, error) {
+		ch, _, _, err := c.api.OpenConversationContext(ctx, &slackapi.OpenConversationParameters{
+			Users:    userIDs,
+			ReturnIM: true,
+		})
+		return ch, err
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	result := channelFromAPI(*ch)
+	return &result, nil
+}
+
+func (c *Client) ArchiveChannel(ctx context.Context, channelID string) error {
+	_, err := retry(ctx, c.writeEndpoint("conversations.archive"), func() (struct{}, error) {
+		return struct{}{}, c.api.ArchiveConversationContext(ctx, channelID)
+	})
+	if err != nil {
+		return classifyError(err)
+	}
+	return nil
+}
+
+func (c *Client) InviteToChannel(ctx context.Context, channelID string, userIDs ...string) error {
+	_, err := retry(ctx, c.writeEndpoint("conversations.invite"), func() (*slackapi.Channel, error) {
+		return c.api.InviteUsersToConversationContext(ctx, channelID, userIDs...)
+	})
+	if err != nil {
+		return classifyError(err)
+	}
+	return nil
+}
+
+// ChannelInvitees returns userIDs followed by the members of userGroupID,
+// when one is given, each only once: conversations.invite rejects the whole
+// batch if it names a user twice or one who is already a member.
+func ChannelInvitees(ctx context.Context, svc Service, userIDs []string, userGroupID string) ([]string, error) {
+	ids := slices.Clone(userIDs)
+	if userGroupID != "" {
+		members, err := svc.ListUserGroupMembers(ctx, userGroupID)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, members...)
+	}
+	return uniqueIDs(ids), nil
+}
+
+// uniqueIDs drops repeats from ids, keeping the first of each.
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	return slices.DeleteFunc(slices.Clone(ids), func(id string) bool {
+		dup := seen[id]
+		seen[id] = true
+		return dup
+	})
+}
+
+func (c *Client) KickFromChannel(ctx context.Context, channelID, userID string) error {
+	_, err := retry(ctx, c.writeEndpoint("conversations.kick"), func() (struct{}, error) {
+		return struct{}{}, c.api.KickUserFromConversationContext(ctx, channelID, userID)
+	})
+	if err != nil {
+		return classifyError(err)
+	}
+	return nil
+}
+
+func (c *Client) SetChannelTopic(ctx context.Context, channelID, topic string) error {
+	_, err := retry(ctx, c.writeEndpoint("conversations.setTopic"), func() (*slackapi.Channel, error) {
+		return c.api.SetTopicOfConversationContext(ctx, channelID, topic)
 	})
 	if err != nil {
 		return classifyError(err)
@@ -125,9 +258,9 @@ func (c *Client) ArchiveChannel(channelID string) error {
 	return nil
 }
 
-func (c *Client) InviteToChannel(channelID string, userIDs ...string) error {
-	_, err := retry(func() (*slackapi.Channel, error) {
-		return c.api.InviteUsersToConversation(channelID, userIDs...)
+func (c *Client) SetChannelPurpose(ctx context.Context, channelID, purpose string) error {
+	_, err := retry(ctx, c.writeEndpoint("conversations.setPurpose"), func() (*slackapi.Channel, error) {
+		return c.api.SetPurposeOfConversationContext(ctx, channelID, purpose)
 	})
 	if err != nil {
 		return classifyError(err)
@@ -135,9 +268,9 @@ func (c *Client) InviteToChannel(channelID string, userIDs ...string) error {
 	return nil
 }
 
-func (c *Client) KickFromChannel(channelID, userID string) error {
-	_, err := retry(func() (struct{}, error) {
-		return struct{}{}, c.api.KickUserFromConversation(channelID, userID)
+func (c *Client) UnarchiveChannel(ctx context.Context, channelID string) error {
+	_, err := retry(ctx, c.writeEndpoint("conversations.unarchive"), func() (struct{}, error) {
+		return struct{}{}, c.api.UnArchiveConversationContext(ctx, channelID)
 	})
 	if err != nil {
 		return classifyError(err)
@@ -145,9 +278,24 @@ func (c *Client) KickFromChannel(channelID, userID string) error {
 	return nil
 }
 
-func (c *Client) SetChannelTopic(channelID, topic string) error {
-	_, err := retry(func() (*slackapi.Channel, error) {
-		return c.api.SetTopicOfConversation(channelID, topic)
+// JoinChannel adds the authenticated user to a public channel.
+func (c *Client) JoinChannel(ctx context.Context, channelID string) (*Channel, error) {
+	ch, err := retry(ctx, c.writeEndpoint("conversations.join"), func() (*slackapi.Channel, error) {
+		ch, _, _, err := c.api.JoinConversationContext(ctx, channelID)
+		return ch, err
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	result := channelFromAPI(*ch)
+	return &result, nil
+}
+
+// LeaveChannel removes the authenticated user from a channel. Leaving a
+// channel the user is not in succeeds.
+func (c *Client) LeaveChannel(ctx context.Context, channelID string) error {
+	_, err := retry(ctx, c.writeEndpoint("conversations.leave"), func() (bool, error) {
+		return c.api.LeaveConversationContext(ctx, channelID)
 	})
 	if err != nil {
 		return classifyError(err)
@@ -155,9 +303,29 @@ func (c *Client) SetChannelTopic(channelID, topic string) error {
 	return nil
 }
 
-func (c *Client) SetChannelPurpose(channelID, purpose string) error {
-	_, err := retry(func() (*slackapi.Channel, error) {
-		return c.api.SetPurposeOfConversation(channelID, purpose)
+func (c *Client) RenameChannel(ctx context.Context, channelID, name string) (*Channel, error) {
+	ch, err := retry(ctx, c.writeEndpoint("conversations.rename"), func() (*slackapi.Channel, error) {
+		return c.api.RenameConversationContext(ctx, channelID, name)
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	result := channelFromAPI(*ch)
+	return &result, nil
+}
+
+// ConvertChannel makes a public channel private or a private channel public.
+// It uses the admin.conversations API, so it needs an Enterprise Grid admin
+// token with the admin.conversations:write scope.
+func (c *Client) ConvertChannel(ctx context.Context, channelID string, private bool) error {
+	method := "admin.conversations.convertToPublic"
+	convert := c.api.AdminConversationsConvertToPublic
+	if private {
+		method = "admin.conversations.convertToPrivate"
+		convert = c.api.AdminConversationsConvertToPrivate
+	}
+	_, err := retry(ctx, c.writeEndpoint(method), func() (struct{}, error) {
+		return struct{}{}, convert(ctx, channelID)
 	})
 	if err != nil {
 		return classifyError(err)
@@ -1,6 +1,7 @@
 package slack
 
 import (
+	"context"
 	"testing"
 
 	slackapi "github.com/slack-go/slack"
@@ -35,6 +36,30 @@ func TestChannelFromAPI(t *testing.T) {
 	assert.True(t, got.IsMember)
 }
 
+func TestChannelFromAPI_IM(t *testing.T) {
+	input := slackapi.Channel{
+		GroupConversation: slackapi.GroupConversation{
+			Conversation: slackapi.Conversation{
+				ID:   "D123ABC",
+				IsIM: true,
+				User: "U456DEF",
+			},
+		},
+	}
+
+	got := channelFromAPI(input)
+
+	assert.Equal(t, "D123ABC", got.ID)
+	assert.True(t, got.IsIM)
+	assert.False(t, got.IsMpIM)
+	assert.Equal(t, "U456DEF", got.User)
+}
+
+func TestListChannelsParams_EffectiveTypes(t *testing.T) {
+	assert.Equal(t, DefaultChannelTypes, ListChannelsParams{}.effectiveTypes())
+	assert.Equal(t, []string{"im", "mpim"}, ListChannelsParams{Types: []string{"im", "mpim"}}.effectiveTypes())
+}
+
 func TestChannelFromAPI_Empty(t *testing.T) {
 	got := channelFromAPI(slackapi.Channel{})
 
@@ -48,3 +73,39 @@ func TestChannelFromAPI_Empty(t *testing.T) {
 	assert.False(t, got.IsMember)
 	assert.Zero(t, got.Created)
 }
+
+// fakeUserGroups serves user group members, panicking on anything else
+// through the nil embedded Service.
+type fakeUserGroups struct {
+	Service
+	members map[string][]string
+}
+
+func (f *fakeUserGroups) ListUserGroupMembers(ctx context.Context, userGroupID string) ([]string, error) {
+	return f.members[userGroupID], nil
+}
+
+func TestChannelInvitees(t *testing.T) {
+	svc := &fakeUserGroups{members: map[string][]string{"S1": {"U3", "U1", "U4"}}}
+
+	tests := []struct {
+		name      string
+		userIDs   []string
+		userGroup string
+		want      []string
+	}{
+		{"users only", []string{"U1", "U2"}, "", []string{"U1", "U2"}},
+		{"repeated user", []string{"U1", "U2", "U1"}, "", []string{"U1", "U2"}},
+		{"group overlaps users", []string{"U1", "U2"}, "S1", []string{"U1", "U2", "U3", "U4"}},
+		{"group only", nil, "S1", []string{"U3", "U1", "U4"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ChannelInvitees(context.Background(), svc, tt.userIDs, tt.userGroup)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -1,27 +1,70 @@
 package slack
 
-import slackapi "github.com/slack-go/slack"
+import (
+	"net/http"
+
+	slackapi "github.com/slack-go/slack"
+)
 
 type Client struct {
-	api   *slackapi.Client
-	token string
+	api         *slackapi.Client
+	token       string
+	limiter     *RateLimiter
+	retryPolicy RetryPolicy
+
+	// httpClient and apiURL serve the methods slack-go does not wrap.
+	httpClient *http.Client
+	apiURL     string
+	// debug logs those methods' requests and responses through api's logger,
+	// as slack-go does for its own.
+	debug bool
 }
 
 type Option func(*Client)
 
 func NewClient(token string, opts ...Option) *Client {
 	c := &Client{
-		api:   slackapi.New(token),
-		token: token,
+		token:       token,
+		limiter:     NewRateLimiter(nil),
+		retryPolicy: DefaultRetryPolicy,
+		httpClient:  http.DefaultClient,
+		apiURL:      slackapi.APIURL,
 	}
 	for _, opt := range opts {
 		opt(c)
 	}
+	c.api = slackapi.New(token, slackapi.OptionAPIURL(c.apiURL), slackapi.OptionDebug(c.debug))
 	return c
 }
 
+// WithDebug logs every API request and response to stderr.
 func WithDebug() Option {
 	return func(c *Client) {
-		c.api = slackapi.New(c.token, slackapi.OptionDebug(true))
+		c.debug = true
+	}
+}
+
+// WithAPIURL points the client at another Web API base URL, such as a proxy
+// or a test server. The URL ends in a slash, as slackapi.APIURL does.
+func WithAPIURL(url string) Option {
+	return func(c *Client) {
+		c.apiURL = url
+	}
+}
+
+// WithRateLimiter replaces the client's rate limiter. Passing the same
+// limiter to several clients makes them share one quota; nil disables
+// proactive limiting and leaves only the Retry-After handling in retry.
+func WithRateLimiter(l *RateLimiter) Option {
+	return func(c *Client) {
+		c.limiter = l
+	}
+}
+
+// WithRetryPolicy sets how many times failed calls are retried and the
+// backoff between attempts.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = p
 	}
 }
@@ -0,0 +1,153 @@
+package slack
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// emojiStandard lists the short names of Slack's built-in emoji, one per
+// line. It is derived from the gemoji names Slack shares, plus the few
+// Slack-only names such as simple_smile.
+//
+//go:embed emoji_standard.txt
+var emojiStandard string
+
+var standardEmoji = sync.OnceValue(func() map[string]bool {
+	names := make(map[string]bool)
+	for name := range strings.Lines(emojiStandard) {
+		if name = strings.TrimSpace(name); name != "" {
+			names[name] = true
+		}
+	}
+	return names
+})
+
+// Emoji is a custom emoji. For an alias, AliasFor names the target and URL
+// is the target's image.
+type Emoji struct {
+	Name     string `json:"name"`
+	URL      string `json:"url,omitempty"`
+	AliasFor string `json:"alias_for,omitempty"`
+}
+
+// ListEmoji returns the workspace's custom emoji sorted by name, with
+// aliases resolved to their targets.
+func (c *Client) ListEmoji(ctx context.Context) ([]Emoji, error) {
+	raw, err := retry(ctx, c.endpoint("emoji.list"), func() (map[string]string, error) {
+		return c.api.GetEmojiContext(ctx)
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	return emojiFromAPI(raw), nil
+}
+
+// emojiFromAPI converts emoji.list's name to URL map, where an alias maps to
+// "alias:<target>". Aliases of standard emoji keep the target name without a
+// URL.
+func emojiFromAPI(raw map[string]string) []Emoji {
+	result := make([]Emoji, 0, len(raw))
+	for name, value := range raw {
+		e := Emoji{Name: name, URL: value}
+		target := name
+		// Follow chains of aliases, guarding against cycles.
+		for range 10 {
+			next, ok := strings.CutPrefix(raw[target], "alias:")
+			if !ok {
+				break
+			}
+			target = next
+		}
+		if target != name {
+			e.AliasFor = target
+			e.URL = raw[target]
+		}
+		result = append(result, e)
+	}
+	slices.SortFunc(result, func(a, b Emoji) int { return strings.Compare(a.Name, b.Name) })
+	return result
+}
+
+// normalizeEmojiName strips the colons users often type around emoji names.
+func normalizeEmojiName(name string) string {
+	return strings.Trim(strings.TrimSpace(name), ":")
+}
+
+// validateEmojiName checks a reaction name against the standard emoji and
+// the workspace's custom emoji. The custom list is only fetched for names
+// that are not standard; if it cannot be fetched, for example without the
+// emoji:read scope, the name is left for Slack to judge.
+func (c *Client) validateEmojiName(ctx context.Context, name string) error {
+	base, tone, hasTone := strings.Cut(name, "::")
+	if hasTone && !standardEmoji()[tone] {
+		return unknownEmojiError(name, "")
+	}
+	if standardEmoji()[base] {
+		return nil
+	}
+
+	custom, err := c.ListEmoji(ctx)
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(custom))
+	for _, e := range custom {
+		if e.Name == base {
+			return nil
+		}
+		names = append(names, e.Name)
+	}
+	return unknownEmojiError(name, closestEmoji(base, names))
+}
+
+func unknownEmojiError(name, suggestion string) *SlackError {
+	detail := fmt.Sprintf("unknown emoji %q", name)
+	if suggestion != "" {
+		detail += fmt.Sprintf("; did you mean %q?", suggestion)
+	}
+	return &SlackError{Code: ErrValidation, Message: "invalid_name", Detail: detail}
+}
+
+// closestEmoji returns the standard or custom emoji name nearest to name by
+// edit distance, or "" when nothing is close enough to be a likely typo.
+func closestEmoji(name string, custom []string) string {
+	best, bestDist := "", max(2, len(name)/3)+1
+	consider := func(candidate string) {
+		d := editDistance(name, candidate)
+		if d < bestDist || (d == bestDist && best != "" && candidate < best) {
+			best, bestDist = candidate, d
+		}
+	}
+	for candidate := range standardEmoji() {
+		consider(candidate)
+	}
+	for _, candidate := range custom {
+		consider(candidate)
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,91 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmojiFromAPI(t *testing.T) {
+	got := emojiFromAPI(map[string]string{
+		"shipit":      "https://emoji.slack-edge.com/T1/shipit/abc.png",
+		"squirrel":    "alias:shipit",
+		"ship-it-now": "alias:squirrel",
+		"yes":         "alias:white_check_mark",
+	})
+
+	assert.Equal(t, []Emoji{
+		{Name: "ship-it-now", URL: "https://emoji.slack-edge.com/T1/shipit/abc.png", AliasFor: "shipit"},
+		{Name: "shipit", URL: "https://emoji.slack-edge.com/T1/shipit/abc.png"},
+		{Name: "squirrel", URL: "https://emoji.slack-edge.com/T1/shipit/abc.png", AliasFor: "shipit"},
+		{Name: "yes", AliasFor: "white_check_mark"},
+	}, got)
+}
+
+func TestClosestEmoji(t *testing.T) {
+	tests := []struct {
+		name   string
+		custom []string
+		want   string
+	}{
+		{"thumbsupp", nil, "thumbsup"},
+		{"white_chek_mark", nil, "white_check_mark"},
+		{"partyparot", []string{"partyparrot"}, "partyparrot"},
+		{"zzzzzzzzzzzzzz", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, closestEmoji(tt.name, tt.custom))
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance("tada", "tada"))
+	assert.Equal(t, 1, editDistance("tad", "tada"))
+	assert.Equal(t, 3, editDistance("kitten", "sitting"))
+	assert.Equal(t, 4, editDistance("", "eyes"))
+}
+
+func TestValidateEmojiName(t *testing.T) {
+	calls := map[string]int{}
+	c := newTestClient(t, counted(calls, map[string]http.HandlerFunc{
+		"emoji.list": respond(`{"ok":true,"emoji":{"partyparrot":"https://emoji.slack-edge.com/T1/partyparrot/abc.gif"}}`),
+	}))
+
+	t.Run("standard", func(t *testing.T) {
+		require.NoError(t, c.validateEmojiName(context.Background(), "thumbsup"))
+		require.NoError(t, c.validateEmojiName(context.Background(), "wave::skin-tone-3"))
+		assert.Empty(t, calls, "standard emoji need no API call")
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		require.NoError(t, c.validateEmojiName(context.Background(), "partyparrot"))
+	})
+
+	t.Run("typo suggests closest", func(t *testing.T) {
+		err := c.validateEmojiName(context.Background(), "partyparot")
+
+		var se *SlackError
+		require.ErrorAs(t, err, &se)
+		assert.Equal(t, ErrValidation, se.Code)
+		assert.Equal(t, "invalid_name", se.Message)
+		assert.Contains(t, se.Detail, `did you mean "partyparrot"?`)
+	})
+
+	t.Run("bad skin tone", func(t *testing.T) {
+		err := c.validateEmojiName(context.Background(), "wave::skin-tone-9")
+
+		var se *SlackError
+		require.ErrorAs(t, err, &se)
+		assert.Equal(t, ErrValidation, se.Code)
+	})
+}
+
+func TestNormalizeEmojiName(t *testing.T) {
+	assert.Equal(t, "thumbsup", normalizeEmojiName(":thumbsup:"))
+	assert.Equal(t, "wave::skin-tone-2", normalizeEmojiName(" :wave::skin-tone-2: "))
+}
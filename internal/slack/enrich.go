@@ -0,0 +1,188 @@
+package slack
+
+import (
+	"context"
+	"iter"
+	"regexp"
+	"strings"
+)
+
+// mentionPattern matches Slack's angle-bracket tokens: <@U123>, <#C123|name>,
+// <!here>, <!subteam^S123|@team>, <https://example.com|label> and so on.
+var mentionPattern = regexp.MustCompile(`<([^<>\s][^<>]*)>`)
+
+var entityReplacer = strings.NewReplacer("&lt;", "<", "&gt;", ">", "&amp;", "&")
+
+// Enricher makes messages readable: it fills in UserName and UserRealName,
+// and rewrites Text with mentions, channel links, user groups and special
+// tokens replaced by names and with entities unescaped, keeping the original
+// in RawText. Names are looked up through the Service and remembered, so
+// one Enricher can be reused across pages.
+//
+// Enrichment is best effort: a name that cannot be looked up is left as the
+// label Slack sent, or the ID.
+type Enricher struct {
+	svc      Service
+	users    map[string]User
+	channels map[string]string
+	groups   map[string]string
+}
+
+func NewEnricher(svc Service) *Enricher {
+	return &Enricher{
+		svc:      svc,
+		users:    make(map[string]User),
+		channels: make(map[string]string),
+	}
+}
+
+// Messages enriches msgs in place, looking up all their users at once.
+func (e *Enricher) Messages(ctx context.Context, msgs []Message) {
+	var ids []string
+	for _, m := range msgs {
+		ids = append(ids, m.User)
+		for _, tok := range mentionPattern.FindAllStringSubmatch(m.Text, -1) {
+			if id, ok := strings.CutPrefix(tok[1], "@"); ok {
+				id, _, _ = strings.Cut(id, "|")
+				ids = append(ids, id)
+			}
+		}
+	}
+	e.loadUsers(ctx, ids)
+
+	for i := range msgs {
+		e.message(ctx, &msgs[i])
+	}
+}
+
+// Seq enriches messages as they stream past.
+func (e *Enricher) Seq(ctx context.Context, seq iter.Seq2[Message, error]) iter.Seq2[Message, error] {
+	return func(yield func(Message, error) bool) {
+		for m, err := range seq {
+			if err == nil {
+				batch := []Message{m}
+				e.Messages(ctx, batch)
+				m = batch[0]
+			}
+			if !yield(m, err) {
+				return
+			}
+		}
+	}
+}
+
+func (e *Enricher) message(ctx context.Context, m *Message) {
+	if u := e.users[m.User]; u.ID != "" {
+		m.UserName = u.Name
+		m.UserRealName = u.RealName
+	}
+	if text := e.Text(ctx, m.Text); text != m.Text {
+		m.RawText = m.Text
+		m.Text = text
+	}
+}
+
+// loadUsers looks up whichever of ids have not been seen yet.
+func (e *Enricher) loadUsers(ctx context.Context, ids []string) {
+	var missing []string
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if _, ok := e.users[id]; ok || id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		missing = append(missing, id)
+	}
+	if len(missing) == 0 {
+		return
+	}
+	// Remember misses too, so an unknown ID is only looked up once.
+	for _, id := range missing {
+		e.users[id] = User{}
+	}
+	// GetUsers still returns the users it found when some IDs are unknown,
+	// and any other error is final.
+	users, _ := e.svc.GetUsers(ctx, missing)
+	for _, u := range users {
+		if u.ID != "" {
+			e.users[u.ID] = u
+		}
+	}
+}
+
+// Text renders Slack mrkdwn tokens in text as plain, readable text.
+func (e *Enricher) Text(ctx context.Context, text string) string {
+	text = mentionPattern.ReplaceAllStringFunc(text, func(tok string) string {
+		return e.token(ctx, tok[1:len(tok)-1])
+	})
+	return entityReplacer.Replace(text)
+}
+
+func (e *Enricher) token(ctx context.Context, tok string) string {
+	body, label, hasLabel := strings.Cut(tok, "|")
+	switch {
+	case strings.HasPrefix(body, "@"):
+		id := body[1:]
+		e.loadUsers(ctx, []string{id})
+		if u := e.users[id]; u.ID != "" {
+			return "@" + u.Name
+		}
+		if hasLabel {
+			return "@" + strings.TrimPrefix(label, "@")
+		}
+		return body
+	case strings.HasPrefix(body, "#"):
+		if hasLabel && label != "" {
+			return "#" + label
+		}
+		return "#" + e.channelName(ctx, body[1:])
+	case strings.HasPrefix(body, "!subteam^"):
+		if hasLabel {
+			return "@" + strings.TrimPrefix(label, "@")
+		}
+		return "@" + e.groupHandle(ctx, strings.TrimPrefix(body, "!subteam^"))
+	case strings.HasPrefix(body, "!"):
+		name, _, _ := strings.Cut(body[1:], "^")
+		switch {
+		case name == "here" || name == "channel" || name == "everyone":
+			return "@" + name
+		case hasLabel:
+			// <!date^...|fallback> and friends carry their own readable text.
+			return label
+		}
+		return "@" + name
+	default:
+		url := strings.TrimPrefix(body, "mailto:")
+		if !hasLabel || label == "" || label == url {
+			return url
+		}
+		return label + " (" + url + ")"
+	}
+}
+
+func (e *Enricher) channelName(ctx context.Context, id string) string {
+	if name, ok := e.channels[id]; ok {
+		return name
+	}
+	name := id
+	if ch, err := e.svc.GetChannelInfo(ctx, id); err == nil && ch.Name != "" {
+		name = ch.Name
+	}
+	e.channels[id] = name
+	return name
+}
+
+func (e *Enricher) groupHandle(ctx context.Context, id string) string {
+	if e.groups == nil {
+		e.groups = make(map[string]string)
+		if groups, err := e.svc.ListUserGroups(ctx, ListUserGroupsParams{IncludeDisabled: true}); err == nil {
+			for _, g := range groups {
+				e.groups[g.ID] = g.Handle
+			}
+		}
+	}
+	if handle, ok := e.groups[id]; ok && handle != "" {
+		return handle
+	}
+	return id
+}
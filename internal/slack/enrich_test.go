@@ -0,0 +1,119 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeDirectory answers the lookups an Enricher makes and panics on
+// anything else through the nil embedded Service.
+type fakeDirectory struct {
+	Service
+	users    map[string]User
+	channels map[string]string
+	calls    map[string]int
+}
+
+// GetUsers returns the users it knows, with a zero User in place of the
+// others and a not_found error, as Client.GetUsers does.
+func (f *fakeDirectory) GetUsers(ctx context.Context, ids []string) ([]User, error) {
+	f.calls["GetUsers"]++
+	users := make([]User, len(ids))
+	var err error
+	for i, id := range ids {
+		if u, ok := f.users[id]; ok {
+			users[i] = u
+		} else {
+			err = &SlackError{Code: ErrNotFound, Message: "user_not_found"}
+		}
+	}
+	return users, err
+}
+
+func (f *fakeDirectory) GetChannelInfo(ctx context.Context, id string) (*Channel, error) {
+	f.calls["GetChannelInfo"]++
+	name, ok := f.channels[id]
+	if !ok {
+		return nil, errors.New("channel_not_found")
+	}
+	return &Channel{ID: id, Name: name}, nil
+}
+
+func (f *fakeDirectory) ListUserGroups(ctx context.Context, params ListUserGroupsParams) ([]UserGroup, error) {
+	f.calls["ListUserGroups"]++
+	return []UserGroup{{ID: "S1", Handle: "oncall"}}, nil
+}
+
+func newFakeDirectory() *fakeDirectory {
+	return &fakeDirectory{
+		users: map[string]User{
+			"U1": {ID: "U1", Name: "alice", RealName: "Alice Smith"},
+			"U2": {ID: "U2", Name: "bob", RealName: "Bob Jones"},
+		},
+		channels: map[string]string{"C1": "general"},
+		calls:    map[string]int{},
+	}
+}
+
+func TestEnricher_Text(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"user mention", "<@U2> can you look?", "@bob can you look?"},
+		{"unknown user keeps label", "<@U9|carol> hi", "@carol hi"},
+		{"unknown user keeps ID", "<@U9> hi", "@U9 hi"},
+		{"labelled channel", "see <#C2|deploys>", "see #deploys"},
+		{"bare channel", "see <#C1>", "see #general"},
+		{"labelled user group", "<!subteam^S1|@oncall> ping", "@oncall ping"},
+		{"bare user group", "<!subteam^S1> ping", "@oncall ping"},
+		{"special mentions", "<!here> and <!channel|channel>", "@here and @channel"},
+		{"date fallback", "due <!date^1700000000^{date_short}|Nov 14, 2023>", "due Nov 14, 2023"},
+		{"labelled link", "<https://example.com|the docs>", "the docs (https://example.com)"},
+		{"bare link", "<https://example.com>", "https://example.com"},
+		{"mailto", "<mailto:alice@example.com|alice@example.com>", "alice@example.com"},
+		{"entities", "a &lt;b&gt; &amp;&amp; c", "a <b> && c"},
+		{"plain text", "nothing to do", "nothing to do"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEnricher(newFakeDirectory())
+			assert.Equal(t, tt.want, e.Text(context.Background(), tt.text))
+		})
+	}
+}
+
+func TestEnricher_Messages(t *testing.T) {
+	dir := newFakeDirectory()
+	e := NewEnricher(dir)
+	msgs := []Message{
+		{User: "U1", Text: "<@U2> see <#C1>"},
+		{User: "U9", Text: "plain"},
+		{User: "U2", Text: "&lt;3"},
+	}
+
+	e.Messages(context.Background(), msgs)
+
+	assert.Equal(t, "alice", msgs[0].UserName)
+	assert.Equal(t, "Alice Smith", msgs[0].UserRealName)
+	assert.Equal(t, "@bob see #general", msgs[0].Text)
+	assert.Equal(t, "<@U2> see <#C1>", msgs[0].RawText)
+
+	assert.Empty(t, msgs[1].UserName, "unknown users are left alone")
+	assert.Empty(t, msgs[1].RawText, "unchanged text keeps no copy")
+
+	assert.Equal(t, "bob", msgs[2].UserName)
+	assert.Equal(t, "<3", msgs[2].Text)
+
+	// U9 is unknown, but the one lookup still found everyone else.
+	assert.Equal(t, 1, dir.calls["GetUsers"])
+
+	e.Messages(context.Background(), slices.Clone(msgs[:2]))
+	assert.Equal(t, 1, dir.calls["GetUsers"], "known and missing users are remembered")
+}
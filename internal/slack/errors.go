@@ -1,6 +1,14 @@
 package slack
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	slackapi "github.com/slack-go/slack"
+)
 
 type ErrorCode string
 
@@ -12,6 +20,8 @@ const (
 	ErrValidation ErrorCode = "validation_error"
 	ErrAPI        ErrorCode = "api_error"
 	ErrNetwork    ErrorCode = "network_error"
+	ErrTimeout    ErrorCode = "timeout"
+	ErrCanceled   ErrorCode = "canceled"
 )
 
 type SlackError struct {
@@ -36,17 +46,66 @@ func classifyError(err error) *SlackError {
 	if err == nil {
 		return nil
 	}
+	var rle *slackapi.RateLimitedError
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return &SlackError{Code: ErrTimeout, Message: err.Error(), Err: err}
+	case errors.Is(err, context.Canceled):
+		return &SlackError{Code: ErrCanceled, Message: err.Error(), Err: err}
+	case errors.As(err, &rle):
+		// Retries are exhausted by the time a rate limit gets here.
+		return &SlackError{Code: ErrRateLimit, Message: "ratelimited", Detail: fmt.Sprintf("retry after %s", rle.RetryAfter), Err: err}
+	case isNetworkError(err):
+		return &SlackError{Code: ErrNetwork, Message: err.Error(), Err: err}
+	}
 	msg := err.Error()
 	switch msg {
+	case "ratelimited":
+		return &SlackError{Code: ErrRateLimit, Message: msg, Err: err}
 	case "invalid_auth", "not_authed", "token_revoked", "token_expired", "account_inactive":
 		return &SlackError{Code: ErrAuth, Message: msg, Err: err}
-	case "channel_not_found", "user_not_found", "file_not_found", "message_not_found":
+	case "channel_not_found", "user_not_found", "file_not_found", "message_not_found",
+		"invalid_scheduled_message_id", "no_pin", "bookmark_not_found", "not_found",
+		"no_such_subteam", "subteam_not_found", "users_not_found":
 		return &SlackError{Code: ErrNotFound, Message: msg, Err: err}
-	case "not_in_channel", "missing_scope", "cannot_dm_bot", "restricted_action":
+	case "not_in_channel", "user_not_in_channel", "cannot_complete_others", "permission_denied",
+		"missing_scope", "cannot_dm_bot", "restricted_action", "cant_leave_general", "not_an_admin",
+		"not_allowed_token_type", "feature_not_enabled":
 		return &SlackError{Code: ErrPermission, Message: msg, Err: err}
-	case "too_many_attachments", "msg_too_long", "no_text", "invalid_blocks":
+	case "too_many_attachments", "msg_too_long", "no_text", "invalid_blocks",
+		"time_in_past", "time_too_far", "invalid_time", "already_pinned", "not_pinnable",
+		"cannot_parse", "cannot_complete_recurring", "name_already_exists",
+		"handle_already_exists", "invalid_name", "invalid_presence", "snooze_not_active",
+		"too_long", "name_taken", "is_archived", "not_archived", "already_archived",
+		"method_not_supported_for_channel_type":
 		return &SlackError{Code: ErrValidation, Message: msg, Err: err}
 	default:
 		return &SlackError{Code: ErrAPI, Message: msg, Err: err}
 	}
 }
+
+// isNetworkError reports whether err is a transient transport or server-side
+// failure: connection resets, DNS failures and timeouts, HTTP 5xx responses,
+// and Slack's internal_error/fatal_error.
+func isNetworkError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var statusErr slackapi.StatusCodeError
+	if errors.As(err, &statusErr) {
+		switch statusErr.Code {
+		case 500, 502, 503, 504:
+			return true
+		}
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	switch err.Error() {
+	case "internal_error", "fatal_error", "service_unavailable", "request_timeout":
+		return true
+	}
+	return false
+}
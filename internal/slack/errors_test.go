@@ -1,9 +1,15 @@
 package slack
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net"
+	"syscall"
 	"testing"
+	"time"
 
+	slackapi "github.com/slack-go/slack"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -26,8 +32,18 @@ func TestClassifyError(t *testing.T) {
 		{"user_not_found", errors.New("user_not_found"), ErrNotFound, "user_not_found"},
 		{"file_not_found", errors.New("file_not_found"), ErrNotFound, "file_not_found"},
 		{"message_not_found", errors.New("message_not_found"), ErrNotFound, "message_not_found"},
+		{"users_not_found", errors.New("users_not_found"), ErrNotFound, "users_not_found"},
+		{"invalid_scheduled_message_id", errors.New("invalid_scheduled_message_id"), ErrNotFound, "invalid_scheduled_message_id"},
+		{"no_pin", errors.New("no_pin"), ErrNotFound, "no_pin"},
+		{"bookmark_not_found", errors.New("bookmark_not_found"), ErrNotFound, "bookmark_not_found"},
+		{"not_found", errors.New("not_found"), ErrNotFound, "not_found"},
+		{"no_such_subteam", errors.New("no_such_subteam"), ErrNotFound, "no_such_subteam"},
+		{"snooze_not_active", errors.New("snooze_not_active"), ErrValidation, "snooze_not_active"},
+		{"name_taken", errors.New("name_taken"), ErrValidation, "name_taken"},
+		{"cant_leave_general", errors.New("cant_leave_general"), ErrPermission, "cant_leave_general"},
 		// permission errors
 		{"not_in_channel", errors.New("not_in_channel"), ErrPermission, "not_in_channel"},
+		{"user_not_in_channel", errors.New("user_not_in_channel"), ErrPermission, "user_not_in_channel"},
 		{"missing_scope", errors.New("missing_scope"), ErrPermission, "missing_scope"},
 		{"cannot_dm_bot", errors.New("cannot_dm_bot"), ErrPermission, "cannot_dm_bot"},
 		{"restricted_action", errors.New("restricted_action"), ErrPermission, "restricted_action"},
@@ -36,7 +52,23 @@ func TestClassifyError(t *testing.T) {
 		{"msg_too_long", errors.New("msg_too_long"), ErrValidation, "msg_too_long"},
 		{"no_text", errors.New("no_text"), ErrValidation, "no_text"},
 		{"invalid_blocks", errors.New("invalid_blocks"), ErrValidation, "invalid_blocks"},
+		{"time_in_past", errors.New("time_in_past"), ErrValidation, "time_in_past"},
+		{"already_pinned", errors.New("already_pinned"), ErrValidation, "already_pinned"},
+		{"cannot_parse", errors.New("cannot_parse"), ErrValidation, "cannot_parse"},
+		// network errors
+		{"internal_error", errors.New("internal_error"), ErrNetwork, "internal_error"},
+		{"fatal_error", errors.New("fatal_error"), ErrNetwork, "fatal_error"},
+		{"http 503", slackapi.StatusCodeError{Code: 503, Status: "503 Service Unavailable"}, ErrNetwork, "slack server error: 503 Service Unavailable"},
+		{"connection reset", &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}, ErrNetwork, "read tcp: connection reset by peer"},
+		{"dns timeout", &net.DNSError{Err: "i/o timeout", Name: "slack.com", IsTimeout: true}, ErrNetwork, "lookup slack.com: i/o timeout"},
+		// rate limits, timeouts and cancellation
+		{"rate limited", &slackapi.RateLimitedError{RetryAfter: 30 * time.Second}, ErrRateLimit, "ratelimited"},
+		{"ratelimited", errors.New("ratelimited"), ErrRateLimit, "ratelimited"},
+		{"context deadline", context.DeadlineExceeded, ErrTimeout, "context deadline exceeded"},
+		{"wrapped deadline", fmt.Errorf("users.list: %w", context.DeadlineExceeded), ErrTimeout, "users.list: context deadline exceeded"},
+		{"cancelled", context.Canceled, ErrCanceled, "context canceled"},
 		// default -> API error
+		{"http 404", slackapi.StatusCodeError{Code: 404, Status: "404 Not Found"}, ErrAPI, "slack server error: 404 Not Found"},
 		{"unknown error", errors.New("something_unexpected"), ErrAPI, "something_unexpected"},
 	}
 
@@ -1,7 +1,9 @@
 package slack
 
 import (
+	"context"
 	"io"
+	"iter"
 	"os"
 
 	slackapi "github.com/slack-go/slack"
@@ -20,6 +22,10 @@ type File struct {
 	Permalink  string `json:"permalink"`
 }
 
+func (f File) String() string {
+	return f.Name
+}
+
 func fileFromAPI(f slackapi.File) File {
 	return File{
 		ID:         f.ID,
@@ -35,14 +41,14 @@ func fileFromAPI(f slackapi.File) File {
 	}
 }
 
-func (c *Client) ListFiles(params PaginationParams, channelID, userID string) (*PaginatedResult[File], error) {
+func (c *Client) ListFiles(ctx context.Context, params PaginationParams, channelID, userID string) (*PaginatedResult[File], error) {
 	if params.All {
-		return c.listAllFiles(params, channelID, userID)
+		return collect(c.IterFiles(ctx, params, channelID, userID))
 	}
-	return c.listFilesPage(params, channelID, userID)
+	return c.listFilesPage(ctx, params, channelID, userID)
 }
 
-func (c *Client) listFilesPage(params PaginationParams, channelID, userID string) (*PaginatedResult[File], error) {
+func (c *Client) listFilesPage(ctx context.Context, params PaginationParams, channelID, userID string) (*PaginatedResult[File], error) {
 	listParams := slackapi.ListFilesParameters{
 		Channel: channelID,
 		User:    userID,
@@ -55,8 +61,8 @@ func (c *Client) listFilesPage(params PaginationParams, channelID, userID string
 		nextParams *slackapi.ListFilesParameters
 	}
 
-	r, err := retry(func() (listResult, error) {
-		files, nextParams, err := c.api.ListFiles(listParams)
+	r, err := retry(ctx, c.endpoint("files.list"), func() (listResult, error) {
+		files, nextParams, err := c.api.ListFilesContext(ctx, listParams)
 		return listResult{files, nextParams}, err
 	})
 	if err != nil {
@@ -80,36 +86,21 @@ func (c *Client) listFilesPage(params PaginationParams, channelID, userID string
 	}, nil
 }
 
-func (c *Client) listAllFiles(params PaginationParams, channelID, userID string) (*PaginatedResult[File], error) {
-	var allItems []File
-	cursor := params.Cursor
-	for {
-		page, err := c.listFilesPage(PaginationParams{
-			Cursor: cursor,
-			Limit:  params.EffectiveLimit(),
-		}, channelID, userID)
-		if err != nil {
-			return nil, err
-		}
-		allItems = append(allItems, page.Items...)
-		if !page.HasMore {
-			break
-		}
-		cursor = page.NextCursor
-	}
-	return &PaginatedResult[File]{
-		Items:   allItems,
-		HasMore: false,
-	}, nil
+// IterFiles yields files page by page as they are fetched, starting at
+// params.Cursor.
+func (c *Client) IterFiles(ctx context.Context, params PaginationParams, channelID, userID string) iter.Seq2[File, error] {
+	return paginate(params.Cursor, func(cursor string) (*PaginatedResult[File], error) {
+		return c.listFilesPage(ctx, PaginationParams{Cursor: cursor, Limit: params.EffectiveLimit()}, channelID, userID)
+	})
 }
 
-func (c *Client) GetFileInfo(fileID string) (*File, error) {
+func (c *Client) GetFileInfo(ctx context.Context, fileID string) (*File, error) {
 	type fileInfoResult struct {
 		file *slackapi.File
 	}
 
-	r, err := retry(func() (fileInfoResult, error) {
-		f, _, _, err := c.api.GetFileInfo(fileID, 0, 0)
+	r, err := retry(ctx, c.endpoint("files.info"), func() (fileInfoResult, error) {
+		f, _, _, err := c.api.GetFileInfoContext(ctx, fileID, 0, 0)
 		return fileInfoResult{f}, err
 	})
 	if err != nil {
@@ -119,7 +110,7 @@ func (c *Client) GetFileInfo(fileID string) (*File, error) {
 	return &result, nil
 }
 
-func (c *Client) UploadFile(channelID, filename, title string, reader io.Reader) (*File, error) {
+func (c *Client) UploadFile(ctx context.Context, channelID, filename, title string, reader io.Reader) (*File, error) {
 	params := slackapi.UploadFileParameters{
 		Channel:  channelID,
 		Filename: filename,
@@ -127,8 +118,8 @@ func (c *Client) UploadFile(channelID, filename, title string, reader io.Reader)
 		Reader:   reader,
 	}
 
-	f, err := retry(func() (*slackapi.FileSummary, error) {
-		return c.api.UploadFile(params)
+	f, err := retry(ctx, c.writeEndpoint("files.getUploadURLExternal"), func() (*slackapi.FileSummary, error) {
+		return c.api.UploadFileContext(ctx, params)
 	})
 	if err != nil {
 		return nil, classifyError(err)
@@ -139,23 +130,23 @@ func (c *Client) UploadFile(channelID, filename, title string, reader io.Reader)
 	}, nil
 }
 
-func (c *Client) DownloadFile(url, destPath string) error {
+func (c *Client) DownloadFile(ctx context.Context, url, destPath string) error {
 	outFile, err := os.Create(destPath)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = outFile.Close() }()
 
-	err = c.api.GetFile(url, outFile)
+	err = c.api.GetFileContext(ctx, url, outFile)
 	if err != nil {
 		return classifyError(err)
 	}
 	return nil
 }
 
-func (c *Client) DeleteFile(fileID string) error {
-	_, err := retry(func() (struct{}, error) {
-		return struct{}{}, c.api.DeleteFile(fileID)
+func (c *Client) DeleteFile(ctx context.Context, fileID string) error {
+	_, err := retry(ctx, c.writeEndpoint("files.delete"), func() (struct{}, error) {
+		return struct{}{}, c.api.DeleteFileContext(ctx, fileID)
 	})
 	if err != nil {
 		return classifyError(err)
@@ -0,0 +1,46 @@
+package slack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newTestClient returns a Client whose Web API calls go to a test server
+// that hands each method, such as "users.info", to its handler. A call to
+// any other method fails the test.
+func newTestClient(t *testing.T, handlers map[string]http.HandlerFunc) *Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method := strings.TrimPrefix(r.URL.Path, "/")
+		h, ok := handlers[method]
+		if !ok {
+			t.Errorf("unexpected call to %s", method)
+			http.NotFound(w, r)
+			return
+		}
+		h(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return NewClient("xoxb-test", WithRateLimiter(nil), WithAPIURL(srv.URL+"/"))
+}
+
+// respond returns a handler that replies with body.
+func respond(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+// counted wraps each handler to count its calls in calls, by method.
+func counted(calls map[string]int, handlers map[string]http.HandlerFunc) map[string]http.HandlerFunc {
+	wrapped := make(map[string]http.HandlerFunc, len(handlers))
+	for method, h := range handlers {
+		wrapped[method] = func(w http.ResponseWriter, r *http.Request) {
+			calls[method]++
+			h(w, r)
+		}
+	}
+	return wrapped
+}
@@ -0,0 +1,282 @@
+package slack
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Tier is a Slack Web API rate limit tier.
+// See https://api.slack.com/apis/rate-limits.
+type Tier int
+
+const (
+	Tier1 Tier = iota + 1
+	Tier2
+	Tier3
+	Tier4
+	// TierPostMessage is the special chat.postMessage limit, applied per channel.
+	TierPostMessage
+)
+
+// Limit is the sustained request rate and burst allowed for a tier.
+type Limit struct {
+	PerMinute int
+	Burst     int
+}
+
+// DefaultLimits mirrors the documented minimum rates of each tier.
+var DefaultLimits = map[Tier]Limit{
+	Tier1:           {PerMinute: 1, Burst: 1},
+	Tier2:           {PerMinute: 20, Burst: 3},
+	Tier3:           {PerMinute: 50, Burst: 5},
+	Tier4:           {PerMinute: 100, Burst: 10},
+	TierPostMessage: {PerMinute: 60, Burst: 1},
+}
+
+// methodTiers maps the Slack methods used by Client to their tier.
+// Methods not listed here are treated as Tier 3.
+var methodTiers = map[string]Tier{
+	"admin.conversations.convertToPrivate": Tier2,
+	"admin.conversations.convertToPublic":  Tier2,
+	"auth.test":                            Tier4,
+	"bookmarks.add":                        Tier2,
+	"bookmarks.edit":                       Tier2,
+	"bookmarks.list":                       Tier3,
+	"bookmarks.remove":                     Tier2,
+	"conversations.archive":                Tier2,
+	"conversations.create":                 Tier2,
+	"conversations.history":                Tier3,
+	"conversations.info":                   Tier3,
+	"conversations.invite":                 Tier3,
+	"conversations.join":                   Tier3,
+	"conversations.kick":                   Tier3,
+	"conversations.leave":                  Tier3,
+	"conversations.list":                   Tier2,
+	"conversations.members":                Tier4,
+	"conversations.open":                   Tier3,
+	"conversations.rename":                 Tier2,
+	"conversations.replies":                Tier3,
+	"conversations.setPurpose":             Tier2,
+	"conversations.setTopic":               Tier2,
+	"conversations.unarchive":              Tier2,
+	"chat.delete":                          Tier3,
+	"chat.deleteScheduledMessage":          Tier3,
+	"chat.getPermalink":                    Tier4,
+	"chat.postEphemeral":                   Tier4,
+	"chat.postMessage":                     TierPostMessage,
+	"chat.scheduleMessage":                 Tier3,
+	"chat.scheduledMessages.list":          Tier3,
+	"chat.update":                          Tier3,
+	"dnd.endSnooze":                        Tier2,
+	"dnd.info":                             Tier3,
+	"dnd.setSnooze":                        Tier2,
+	"emoji.list":                           Tier2,
+	"files.delete":                         Tier3,
+	"files.getUploadURLExternal":           Tier4,
+	"files.info":                           Tier4,
+	"files.list":                           Tier3,
+	"pins.add":                             Tier2,
+	"pins.list":                            Tier2,
+	"pins.remove":                          Tier2,
+	"reactions.add":                        Tier3,
+	"reactions.list":                       Tier2,
+	"reactions.remove":                     Tier2,
+	"reminders.add":                        Tier2,
+	"reminders.complete":                   Tier2,
+	"reminders.delete":                     Tier2,
+	"reminders.info":                       Tier2,
+	"reminders.list":                       Tier2,
+	"search.all":                           Tier2,
+	"search.files":                         Tier2,
+	"search.messages":                      Tier2,
+	"usergroups.create":                    Tier2,
+	"usergroups.disable":                   Tier2,
+	"usergroups.enable":                    Tier2,
+	"usergroups.list":                      Tier2,
+	"usergroups.update":                    Tier2,
+	"usergroups.users.list":                Tier2,
+	"usergroups.users.update":              Tier2,
+	"users.getPresence":                    Tier3,
+	"users.info":                           Tier4,
+	"users.list":                           Tier2,
+	"users.lookupByEmail":                  Tier3,
+	"users.profile.set":                    Tier3,
+	"users.setPresence":                    Tier2,
+}
+
+func methodTier(method string) Tier {
+	if t, ok := methodTiers[method]; ok {
+		return t
+	}
+	return Tier3
+}
+
+// RateLimiter throttles calls per Slack method before they are sent, so
+// concurrent callers stay under quota instead of all hitting 429 at once.
+// It is safe for concurrent use and is shared by every caller of a Client.
+type RateLimiter struct {
+	limits map[Tier]Limit
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+// NewRateLimiter returns a limiter using DefaultLimits, with any tiers
+// present in overrides replaced.
+func NewRateLimiter(overrides map[Tier]Limit) *RateLimiter {
+	limits := make(map[Tier]Limit, len(DefaultLimits))
+	for t, l := range DefaultLimits {
+		limits[t] = l
+	}
+	for t, l := range overrides {
+		limits[t] = l
+	}
+	return &RateLimiter{
+		limits:  limits,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// Wait blocks until a call to method may be made, or ctx is done.
+// key narrows the bucket further, e.g. to a channel for chat.postMessage.
+func (l *RateLimiter) Wait(ctx context.Context, method, key string) error {
+	for {
+		l.mu.Lock()
+		b := l.bucket(method, key)
+		wait := b.reserve(time.Now())
+		pauses := b.pauses
+		l.mu.Unlock()
+
+		if wait <= 0 {
+			return nil
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+
+		// A Retry-After arrived while we slept; take a fresh slot after it.
+		l.mu.Lock()
+		paused := b.pauses != pauses
+		l.mu.Unlock()
+		if !paused {
+			return nil
+		}
+	}
+}
+
+// Pause holds back every caller of method (and key) for d, typically the
+// Retry-After duration Slack returned.
+func (l *RateLimiter) Pause(method, key string, d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.bucket(method, key).pause(time.Now(), d)
+}
+
+func (l *RateLimiter) bucket(method, key string) *bucket {
+	tier := methodTier(method)
+	id := method
+	if tier == TierPostMessage && key != "" {
+		id += "/" + key
+	}
+	b, ok := l.buckets[id]
+	if !ok {
+		limit := l.limits[tier]
+		b = newBucket(limit)
+		l.buckets[id] = b
+	}
+	return b
+}
+
+// bucket is a token bucket whose balance may go negative: each reservation
+// takes a token immediately and waits off any debt.
+type bucket struct {
+	rate   float64 // tokens per second
+	burst  float64
+	tokens float64
+	last   time.Time
+	pauses int
+}
+
+func newBucket(limit Limit) *bucket {
+	burst := max(limit.Burst, 1)
+	return &bucket{
+		rate:   float64(limit.PerMinute) / 60,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+func (b *bucket) reserve(now time.Time) time.Duration {
+	if b.rate <= 0 {
+		return 0
+	}
+	if b.last.IsZero() {
+		b.last = now
+	}
+	if now.After(b.last) {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+	b.tokens--
+
+	// last is in the future while a pause is in effect.
+	wait := b.last.Sub(now)
+	if b.tokens < 0 {
+		wait += time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	return wait
+}
+
+func (b *bucket) pause(now time.Time, d time.Duration) {
+	until := now.Add(d)
+	if until.After(b.last) {
+		b.last = until
+	}
+	// Waiters re-reserve once the pause is noticed, so drop their debt.
+	b.tokens = 1
+	b.pauses++
+}
+
+// endpoint identifies the Slack method a call is made against so retry can
+// throttle it and decide whether a failure is safe to retry. The zero value
+// is not throttled and not retried.
+type endpoint struct {
+	limiter *RateLimiter
+	policy  RetryPolicy
+	method  string
+	key     string
+	write   bool
+}
+
+func (c *Client) endpoint(method string) endpoint {
+	return endpoint{limiter: c.limiter, policy: c.retryPolicy, method: method}
+}
+
+// writeEndpoint is endpoint for calls that change state and so must not be
+// repeated after an ambiguous network failure unless the policy allows it.
+func (c *Client) writeEndpoint(method string) endpoint {
+	ep := c.endpoint(method)
+	ep.write = true
+	return ep
+}
+
+// forChannel scopes the endpoint to a channel, for per-channel limits.
+func (e endpoint) forChannel(channelID string) endpoint {
+	e.key = channelID
+	return e
+}
+
+func (e endpoint) wait(ctx context.Context) error {
+	if e.limiter == nil {
+		return nil
+	}
+	return e.limiter.Wait(ctx, e.method, e.key)
+}
+
+func (e endpoint) pause(d time.Duration) {
+	if e.limiter == nil {
+		return
+	}
+	e.limiter.Pause(e.method, e.key, d)
+}
@@ -0,0 +1,133 @@
+package slack
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	slackapi "github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMethodTier(t *testing.T) {
+	tests := []struct {
+		method string
+		want   Tier
+	}{
+		{"conversations.list", Tier2},
+		{"users.info", Tier4},
+		{"chat.postMessage", TierPostMessage},
+		{"unknown.method", Tier3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			assert.Equal(t, tt.want, methodTier(tt.method))
+		})
+	}
+}
+
+func TestBucket_Reserve(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	t.Run("burst is free then calls are spaced", func(t *testing.T) {
+		b := newBucket(Limit{PerMinute: 60, Burst: 2})
+
+		assert.Zero(t, b.reserve(now))
+		assert.Zero(t, b.reserve(now))
+		assert.Equal(t, time.Second, b.reserve(now))
+		assert.Equal(t, 2*time.Second, b.reserve(now))
+	})
+
+	t.Run("tokens refill over time up to burst", func(t *testing.T) {
+		b := newBucket(Limit{PerMinute: 60, Burst: 1})
+
+		assert.Zero(t, b.reserve(now))
+		assert.Zero(t, b.reserve(now.Add(time.Second)))
+		assert.Zero(t, b.reserve(now.Add(time.Hour)))
+		assert.Equal(t, time.Second, b.reserve(now.Add(time.Hour)))
+	})
+
+	t.Run("pause delays the next reservation", func(t *testing.T) {
+		b := newBucket(Limit{PerMinute: 60, Burst: 5})
+
+		b.pause(now, 30*time.Second)
+
+		assert.Equal(t, 30*time.Second, b.reserve(now))
+		assert.Equal(t, 31*time.Second, b.reserve(now))
+	})
+
+	t.Run("zero rate is unlimited", func(t *testing.T) {
+		b := newBucket(Limit{})
+
+		for range 10 {
+			assert.Zero(t, b.reserve(now))
+		}
+	})
+}
+
+func TestNewRateLimiter_Overrides(t *testing.T) {
+	l := NewRateLimiter(map[Tier]Limit{Tier2: {PerMinute: 600, Burst: 1}})
+
+	assert.Equal(t, Limit{PerMinute: 600, Burst: 1}, l.limits[Tier2])
+	assert.Equal(t, DefaultLimits[Tier3], l.limits[Tier3])
+}
+
+func TestRateLimiter_PostMessagePerChannel(t *testing.T) {
+	l := NewRateLimiter(nil)
+
+	assert.NotSame(t, l.bucket("chat.postMessage", "C1"), l.bucket("chat.postMessage", "C2"))
+	assert.Same(t, l.bucket("chat.update", "C1"), l.bucket("chat.update", "C2"))
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	t.Run("shared across goroutines", func(t *testing.T) {
+		l := NewRateLimiter(map[Tier]Limit{Tier2: {PerMinute: 60 * 50, Burst: 1}})
+
+		start := time.Now()
+		var wg sync.WaitGroup
+		for range 5 {
+			wg.Go(func() {
+				assert.NoError(t, l.Wait(context.Background(), "conversations.list", ""))
+			})
+		}
+		wg.Wait()
+
+		// 50/s with burst 1: the fifth caller waits for four intervals.
+		assert.GreaterOrEqual(t, time.Since(start), 4*20*time.Millisecond)
+	})
+
+	t.Run("respects context", func(t *testing.T) {
+		l := NewRateLimiter(nil)
+		l.Pause("conversations.list", "", time.Hour)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := l.Wait(ctx, "conversations.list", "")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestRetry_PausesEndpointOnRateLimit(t *testing.T) {
+	l := NewRateLimiter(nil)
+	ep := endpoint{limiter: l, policy: DefaultRetryPolicy, method: "conversations.list"}
+
+	calls := 0
+	_, err := retry(context.Background(), ep, func() (string, error) {
+		calls++
+		if calls == 1 {
+			return "", &slackapi.RateLimitedError{RetryAfter: 20 * time.Millisecond}
+		}
+		return "ok", nil
+	})
+	require.NoError(t, err)
+
+	l.mu.Lock()
+	b := l.bucket("conversations.list", "")
+	pauses := b.pauses
+	l.mu.Unlock()
+	assert.Equal(t, 1, pauses)
+}
@@ -1,47 +1,106 @@
 package slack
 
 import (
-	"strconv"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
 	"time"
 
 	slackapi "github.com/slack-go/slack"
 )
 
+// Message is a Slack message. UserName, UserRealName and RawText are only
+// set by an Enricher, which leaves the original mrkdwn in RawText when it
+// rewrites Text.
+//
+// Subtype tells ordinary messages apart from joins, topic changes and the
+// like; bot posts carry bot_message, a BotID and the Username they were
+// posted as. ReplyCount is set on thread parents. Blocks and Attachments are
+// passed through as Slack sent them. Fields tagged table:"-" are left out of
+// list tables to keep them readable; JSON output has everything.
 type Message struct {
-	Timestamp string `json:"timestamp"`
+	Timestamp    string          `json:"timestamp"`
+	User         string          `json:"user"`
+	UserName     string          `json:"user_name,omitempty"`
+	UserRealName string          `json:"user_real_name,omitempty" table:"-"`
+	Text         string          `json:"text"`
+	RawText      string          `json:"raw_text,omitempty" table:"-"`
+	ThreadTS     string          `json:"thread_ts,omitempty"`
+	Channel      string          `json:"channel,omitempty"`
+	Type         string          `json:"type" table:"-"`
+	Subtype      string          `json:"subtype,omitempty"`
+	BotID        string          `json:"bot_id,omitempty" table:"-"`
+	Username     string          `json:"username,omitempty" table:"-"`
+	ReplyCount   int             `json:"reply_count,omitempty"`
+	LatestReply  string          `json:"latest_reply,omitempty" table:"-"`
+	Reactions    []Reaction      `json:"reactions,omitempty"`
+	Files        []File          `json:"files,omitempty" table:"-"`
+	Edited       *MessageEdit    `json:"edited,omitempty" table:"-"`
+	Blocks       json.RawMessage `json:"blocks,omitempty" table:"-"`
+	Attachments  json.RawMessage `json:"attachments,omitempty" table:"-"`
+	Permalink    string          `json:"permalink,omitempty" table:"-"`
+}
+
+// MessageEdit records who last edited a message, and when.
+type MessageEdit struct {
 	User      string `json:"user"`
-	Text      string `json:"text"`
-	ThreadTS  string `json:"thread_ts,omitempty"`
-	Channel   string `json:"channel,omitempty"`
-	Type      string `json:"type"`
-	Permalink string `json:"permalink,omitempty"`
+	Timestamp string `json:"ts"`
+}
+
+func (e MessageEdit) String() string {
+	return e.Timestamp + " by " + e.User
 }
 
 func messageFromAPI(msg slackapi.Message) Message {
-	return Message{
-		Timestamp: msg.Timestamp,
-		User:      msg.User,
-		Text:      msg.Text,
-		ThreadTS:  msg.ThreadTimestamp,
-		Type:      msg.Type,
+	m := Message{
+		Timestamp:   msg.Timestamp,
+		User:        msg.User,
+		Text:        msg.Text,
+		ThreadTS:    msg.ThreadTimestamp,
+		Type:        msg.Type,
+		Subtype:     msg.SubType,
+		BotID:       msg.BotID,
+		Username:    msg.Username,
+		ReplyCount:  msg.ReplyCount,
+		LatestReply: msg.LatestReply,
+	}
+	for _, rx := range msg.Reactions {
+		m.Reactions = append(m.Reactions, Reaction{Name: rx.Name, Count: rx.Count, Users: rx.Users})
 	}
+	for _, f := range msg.Files {
+		m.Files = append(m.Files, fileFromAPI(f))
+	}
+	if msg.Edited != nil {
+		m.Edited = &MessageEdit{User: msg.Edited.User, Timestamp: msg.Edited.Timestamp}
+	}
+	if len(msg.Blocks.BlockSet) > 0 {
+		m.Blocks, _ = json.Marshal(msg.Blocks)
+	}
+	if len(msg.Attachments) > 0 {
+		m.Attachments, _ = json.Marshal(msg.Attachments)
+	}
+	return m
 }
 
+// ListMessagesParams selects channel history. Permalinks fills in each
+// message's Permalink, at the cost of one chat.getPermalink call per message.
 type ListMessagesParams struct {
 	ChannelID  string
 	Pagination PaginationParams
 	Oldest     time.Time
 	Latest     time.Time
+	Permalinks bool
 }
 
-func (c *Client) ListMessages(params ListMessagesParams) (*PaginatedResult[Message], error) {
+func (c *Client) ListMessages(ctx context.Context, params ListMessagesParams) (*PaginatedResult[Message], error) {
 	if params.Pagination.All {
-		return c.listAllMessages(params)
+		return collect(c.IterMessages(ctx, params))
 	}
-	return c.listMessagesPage(params)
+	return c.listMessagesPage(ctx, params)
 }
 
-func (c *Client) listMessagesPage(params ListMessagesParams) (*PaginatedResult[Message], error) {
+func (c *Client) listMessagesPage(ctx context.Context, params ListMessagesParams) (*PaginatedResult[Message], error) {
 	type result struct {
 		messages []slackapi.Message
 		hasMore  bool
@@ -60,8 +119,8 @@ func (c *Client) listMessagesPage(params ListMessagesParams) (*PaginatedResult[M
 		histParams.Latest = formatTimestamp(params.Latest)
 	}
 
-	r, err := retry(func() (result, error) {
-		resp, err := c.api.GetConversationHistory(histParams)
+	r, err := retry(ctx, c.endpoint("conversations.history"), func() (result, error) {
+		resp, err := c.api.GetConversationHistoryContext(ctx, histParams)
 		if err != nil {
 			return result{}, err
 		}
@@ -80,6 +139,11 @@ func (c *Client) listMessagesPage(params ListMessagesParams) (*PaginatedResult[M
 		items[i] = messageFromAPI(msg)
 		items[i].Channel = params.ChannelID
 	}
+	if params.Permalinks {
+		if err := c.fillPermalinks(ctx, items); err != nil {
+			return nil, err
+		}
+	}
 	return &PaginatedResult[Message]{
 		Items:      items,
 		NextCursor: r.cursor,
@@ -87,35 +151,33 @@ func (c *Client) listMessagesPage(params ListMessagesParams) (*PaginatedResult[M
 	}, nil
 }
 
-func (c *Client) listAllMessages(params ListMessagesParams) (*PaginatedResult[Message], error) {
-	var allItems []Message
-	cursor := params.Pagination.Cursor
-	for {
+// IterMessages yields channel history page by page as it is fetched,
+// starting at params.Pagination.Cursor.
+func (c *Client) IterMessages(ctx context.Context, params ListMessagesParams) iter.Seq2[Message, error] {
+	return paginate(params.Pagination.Cursor, func(cursor string) (*PaginatedResult[Message], error) {
 		p := params
 		p.Pagination = PaginationParams{Cursor: cursor, Limit: params.Pagination.EffectiveLimit()}
-		page, err := c.listMessagesPage(p)
-		if err != nil {
-			return nil, err
-		}
-		allItems = append(allItems, page.Items...)
-		if !page.HasMore {
-			break
-		}
-		cursor = page.NextCursor
-	}
-	return &PaginatedResult[Message]{Items: allItems, HasMore: false}, nil
+		return c.listMessagesPage(ctx, p)
+	})
 }
 
+// SendMessageParams describes a message to post. Blocks and Attachments are
+// raw Block Kit and legacy attachment JSON; when they are set, Text is the
+// notification fallback. Permalink fetches the posted message's permalink.
 type SendMessageParams struct {
 	ChannelID      string
 	Text           string
 	ThreadTS       string
 	ReplyBroadcast bool
+	Blocks         json.RawMessage
+	Attachments    json.RawMessage
+	Permalink      bool
 }
 
-func (c *Client) SendMessage(params SendMessageParams) (*Message, error) {
-	opts := []slackapi.MsgOption{
-		slackapi.MsgOptionText(params.Text, false),
+func (c *Client) SendMessage(ctx context.Context, params SendMessageParams) (*Message, error) {
+	opts, err := messageOptions(params.Text, params.Blocks, params.Attachments)
+	if err != nil {
+		return nil, err
 	}
 	if params.ThreadTS != "" {
 		opts = append(opts, slackapi.MsgOptionTS(params.ThreadTS))
@@ -130,33 +192,91 @@ func (c *Client) SendMessage(params SendMessageParams) (*Message, error) {
 		text      string
 	}
 
-	r, err := retry(func() (result, error) {
-		ch, ts, txt, err := c.api.SendMessage(params.ChannelID, opts...)
+	r, err := retry(ctx, c.writeEndpoint("chat.postMessage").forChannel(params.ChannelID), func() (result, error) {
+		ch, ts, txt, err := c.api.SendMessageContext(ctx, params.ChannelID, opts...)
 		return result{ch, ts, txt}, err
 	})
 	if err != nil {
 		return nil, classifyError(err)
 	}
-	return &Message{
+	msg := &Message{
 		Channel:   r.channel,
 		Timestamp: r.timestamp,
 		Text:      r.text,
 		ThreadTS:  params.ThreadTS,
+	}
+	if params.Permalink {
+		// The message is already posted, so a failed lookup should not
+		// read as a failed send; it just goes without a permalink.
+		if link, err := c.GetPermalink(ctx, msg.Channel, msg.Timestamp); err == nil {
+			msg.Permalink = link
+		}
+	}
+	return msg, nil
+}
+
+// PostEphemeralParams describes a message shown only to UserID in
+// ChannelID. Blocks is raw Block Kit JSON, as in SendMessageParams.
+type PostEphemeralParams struct {
+	ChannelID string
+	UserID    string
+	Text      string
+	Blocks    json.RawMessage
+	ThreadTS  string
+}
+
+// PostEphemeral posts a message that only params.UserID can see. Ephemeral
+// messages are not stored, so the returned timestamp cannot be used to edit
+// or delete them.
+func (c *Client) PostEphemeral(ctx context.Context, params PostEphemeralParams) (*Message, error) {
+	opts, err := messageOptions(params.Text, params.Blocks, nil)
+	if err != nil {
+		return nil, err
+	}
+	if params.ThreadTS != "" {
+		opts = append(opts, slackapi.MsgOptionTS(params.ThreadTS))
+	}
+
+	ts, err := retry(ctx, c.writeEndpoint("chat.postEphemeral"), func() (string, error) {
+		return c.api.PostEphemeralContext(ctx, params.ChannelID, params.UserID, opts...)
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	return &Message{
+		Channel:   params.ChannelID,
+		User:      params.UserID,
+		Timestamp: ts,
+		Text:      params.Text,
+		ThreadTS:  params.ThreadTS,
+		Type:      "ephemeral",
 	}, nil
 }
 
-func (c *Client) EditMessage(channelID, timestamp, text string) (*Message, error) {
+// EditMessageParams describes the new content of a message. As with
+// SendMessageParams, Blocks and Attachments are raw JSON.
+type EditMessageParams struct {
+	ChannelID   string
+	Timestamp   string
+	Text        string
+	Blocks      json.RawMessage
+	Attachments json.RawMessage
+}
+
+func (c *Client) EditMessage(ctx context.Context, params EditMessageParams) (*Message, error) {
+	opts, err := messageOptions(params.Text, params.Blocks, params.Attachments)
+	if err != nil {
+		return nil, err
+	}
+
 	type result struct {
 		channel   string
 		timestamp string
 		text      string
 	}
 
-	r, err := retry(func() (result, error) {
-		ch, ts, txt, err := c.api.UpdateMessage(channelID,
-			timestamp,
-			slackapi.MsgOptionText(text, false),
-		)
+	r, err := retry(ctx, c.writeEndpoint("chat.update"), func() (result, error) {
+		ch, ts, txt, err := c.api.UpdateMessageContext(ctx, params.ChannelID, params.Timestamp, opts...)
 		return result{ch, ts, txt}, err
 	})
 	if err != nil {
@@ -169,9 +289,9 @@ func (c *Client) EditMessage(channelID, timestamp, text string) (*Message, error
 	}, nil
 }
 
-func (c *Client) DeleteMessage(channelID, timestamp string) error {
-	_, err := retry(func() (struct{}, error) {
-		_, _, err := c.api.DeleteMessage(channelID, timestamp)
+func (c *Client) DeleteMessage(ctx context.Context, channelID, timestamp string) error {
+	_, err := retry(ctx, c.writeEndpoint("chat.delete"), func() (struct{}, error) {
+		_, _, err := c.api.DeleteMessageContext(ctx, channelID, timestamp)
 		return struct{}{}, err
 	})
 	if err != nil {
@@ -180,34 +300,122 @@ func (c *Client) DeleteMessage(channelID, timestamp string) error {
 	return nil
 }
 
+// DefaultSearchMaxResults caps how many matches a search collects when
+// Pagination.All is set and SearchParams.MaxResults is zero.
+const DefaultSearchMaxResults = 1000
+
+// SearchParams describes a search. Search results are paged rather than
+// cursor-based: Pagination.Limit is the page size and Page the 1-based page
+// to fetch, where 0 means the first page.
 type SearchParams struct {
 	Query      string
 	Sort       string
 	SortDir    string
+	Page       int
 	Pagination PaginationParams
+	// MaxResults caps the matches collected when Pagination.All is set.
+	MaxResults int
+}
+
+// SearchPaging reports where a search result sits among the pages.
+type SearchPaging struct {
+	Page    int  `json:"page"`
+	Pages   int  `json:"pages"`
+	PerPage int  `json:"per_page"`
+	HasMore bool `json:"has_more"`
 }
 
 type SearchResult struct {
 	Matches []Message `json:"matches"`
 	Total   int       `json:"total"`
+	SearchPaging
+}
+
+// SearchMessages returns one page of matches, or with Pagination.All every
+// page from params.Page on until the last page or params.MaxResults matches.
+// When collecting, Page in the result is the last page fetched, so a later
+// call can continue from Page+1 while HasMore is set.
+func (c *Client) SearchMessages(ctx context.Context, params SearchParams) (*SearchResult, error) {
+	if !params.Pagination.All {
+		return c.searchMessagesPage(ctx, params)
+	}
+	var result SearchResult
+	err := collectSearch(params, &result.Matches, &result.Total, &result.SearchPaging, func(p SearchParams) ([]Message, int, SearchPaging, error) {
+		page, err := c.searchMessagesPage(ctx, p)
+		if err != nil {
+			return nil, 0, SearchPaging{}, err
+		}
+		return page.Matches, page.Total, page.SearchPaging, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
 }
 
-func (c *Client) SearchMessages(params SearchParams) (*SearchResult, error) {
-	searchParams := slackapi.SearchParameters{
-		Sort:          params.Sort,
-		SortDirection: params.SortDir,
-		Count:         params.Pagination.EffectiveLimit(),
-		Page:          1,
+// collectSearch fetches search pages from params.Page on into matches,
+// total and paging, stopping after the last page or params.MaxResults
+// matches. paging ends up describing the last page fetched, with HasMore
+// also set when matches were cut off at the cap.
+func collectSearch[T any](params SearchParams, matches *[]T, total *int, paging *SearchPaging, fetch func(SearchParams) ([]T, int, SearchPaging, error)) error {
+	maxResults := params.MaxResults
+	if maxResults <= 0 {
+		maxResults = DefaultSearchMaxResults
 	}
+	*matches = []T{}
+	for {
+		page, pageTotal, pagePaging, err := fetch(params)
+		if err != nil {
+			return err
+		}
+		*matches = append(*matches, page...)
+		*total, *paging = pageTotal, pagePaging
+		if len(*matches) >= maxResults {
+			paging.HasMore = paging.HasMore || len(*matches) > maxResults
+			*matches = (*matches)[:maxResults]
+			return nil
+		}
+		if !pagePaging.HasMore || len(page) == 0 {
+			return nil
+		}
+		params.Page = pagePaging.Page + 1
+	}
+}
 
-	r, err := retry(func() (*slackapi.SearchMessages, error) {
-		msgs, err := c.api.SearchMessages(params.Query, searchParams)
+func (c *Client) searchMessagesPage(ctx context.Context, params SearchParams) (*SearchResult, error) {
+	r, err := retry(ctx, c.endpoint("search.messages"), func() (*slackapi.SearchMessages, error) {
+		msgs, err := c.api.SearchMessagesContext(ctx, params.Query, params.apiParams())
 		return msgs, err
 	})
 	if err != nil {
 		return nil, classifyError(err)
 	}
+	return searchResultFromAPI(r), nil
+}
+
+func (p SearchParams) apiParams() slackapi.SearchParameters {
+	page := p.Page
+	if page < 1 {
+		page = 1
+	}
+	return slackapi.SearchParameters{
+		Sort:          p.Sort,
+		SortDirection: p.SortDir,
+		Count:         p.Pagination.EffectiveLimit(),
+		Page:          page,
+	}
+}
 
+func searchPagingFromAPI(p slackapi.Paging) SearchPaging {
+	return SearchPaging{
+		Page:    p.Page,
+		Pages:   p.Pages,
+		PerPage: p.Count,
+		HasMore: p.Page < p.Pages,
+	}
+}
+
+func searchResultFromAPI(r *slackapi.SearchMessages) *SearchResult {
 	matches := make([]Message, len(r.Matches))
 	for i, m := range r.Matches {
 		matches[i] = Message{
@@ -220,11 +428,12 @@ func (c *Client) SearchMessages(params SearchParams) (*SearchResult, error) {
 		}
 	}
 	return &SearchResult{
-		Matches: matches,
-		Total:   r.Total,
-	}, nil
+		Matches:      matches,
+		Total:        r.Total,
+		SearchPaging: searchPagingFromAPI(r.Paging),
+	}
 }
 
 func formatTimestamp(t time.Time) string {
-	return strconv.FormatInt(t.Unix(), 10) + ".000000"
+	return fmt.Sprintf("%d.%06d", t.Unix(), t.Nanosecond()/int(time.Microsecond))
 }
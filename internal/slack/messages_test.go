@@ -6,6 +6,7 @@ import (
 
 	slackapi "github.com/slack-go/slack"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMessageFromAPI(t *testing.T) {
@@ -30,6 +31,40 @@ func TestMessageFromAPI(t *testing.T) {
 	assert.Empty(t, got.Channel)
 }
 
+func TestMessageFromAPI_Details(t *testing.T) {
+	input := slackapi.Message{
+		Msg: slackapi.Msg{
+			Timestamp:   "1700000000.000100",
+			Type:        "message",
+			SubType:     "bot_message",
+			BotID:       "B123",
+			Username:    "deploybot",
+			ReplyCount:  3,
+			LatestReply: "1700000100.000200",
+			Reactions:   []slackapi.ItemReaction{{Name: "eyes", Count: 2, Users: []string{"U1", "U2"}}},
+			Files:       []slackapi.File{{ID: "F123", Name: "build.log"}},
+			Edited:      &slackapi.Edited{User: "U1", Timestamp: "1700000050.000000"},
+			Blocks:      slackapi.Blocks{BlockSet: []slackapi.Block{slackapi.NewDividerBlock()}},
+			Attachments: []slackapi.Attachment{{Fallback: "deployed"}},
+		},
+	}
+
+	got := messageFromAPI(input)
+
+	assert.Equal(t, "bot_message", got.Subtype)
+	assert.Equal(t, "B123", got.BotID)
+	assert.Equal(t, "deploybot", got.Username)
+	assert.Equal(t, 3, got.ReplyCount)
+	assert.Equal(t, "1700000100.000200", got.LatestReply)
+	assert.Equal(t, []Reaction{{Name: "eyes", Count: 2, Users: []string{"U1", "U2"}}}, got.Reactions)
+	assert.Equal(t, ":eyes: 2", got.Reactions[0].String())
+	require.Len(t, got.Files, 1)
+	assert.Equal(t, "F123", got.Files[0].ID)
+	assert.Equal(t, &MessageEdit{User: "U1", Timestamp: "1700000050.000000"}, got.Edited)
+	assert.JSONEq(t, `[{"type":"divider"}]`, string(got.Blocks))
+	assert.Contains(t, string(got.Attachments), `"fallback":"deployed"`)
+}
+
 func TestMessageFromAPI_Empty(t *testing.T) {
 	got := messageFromAPI(slackapi.Message{})
 
@@ -38,6 +73,10 @@ func TestMessageFromAPI_Empty(t *testing.T) {
 	assert.Empty(t, got.Text)
 	assert.Empty(t, got.ThreadTS)
 	assert.Empty(t, got.Type)
+	assert.Nil(t, got.Reactions)
+	assert.Nil(t, got.Edited)
+	assert.Nil(t, got.Blocks)
+	assert.Nil(t, got.Attachments)
 }
 
 func TestFormatTimestamp(t *testing.T) {
@@ -48,6 +87,7 @@ func TestFormatTimestamp(t *testing.T) {
 	}{
 		{"unix epoch", time.Unix(0, 0), "0.000000"},
 		{"specific time", time.Unix(1700000000, 0), "1700000000.000000"},
+		{"message timestamp", time.Unix(1700000000, 100000), "1700000000.000100"},
 	}
 
 	for _, tt := range tests {
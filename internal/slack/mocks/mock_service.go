@@ -10,8 +10,11 @@
 package mocks
 
 import (
+	context "context"
 	io "io"
+	iter "iter"
 	reflect "reflect"
+	time "time"
 
 	slack "github.com/jackchuka/slackcli/internal/slack"
 	gomock "go.uber.org/mock/gomock"
@@ -41,185 +44,465 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AddBookmark mocks base method.
+func (m *MockService) AddBookmark(ctx context.Context, params slack.AddBookmarkParams) (*slack.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBookmark", ctx, params)
+	ret0, _ := ret[0].(*slack.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBookmark indicates an expected call of AddBookmark.
+func (mr *MockServiceMockRecorder) AddBookmark(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBookmark", reflect.TypeOf((*MockService)(nil).AddBookmark), ctx, params)
+}
+
+// AddPin mocks base method.
+func (m *MockService) AddPin(ctx context.Context, channelID, timestamp string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPin", ctx, channelID, timestamp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPin indicates an expected call of AddPin.
+func (mr *MockServiceMockRecorder) AddPin(ctx, channelID, timestamp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPin", reflect.TypeOf((*MockService)(nil).AddPin), ctx, channelID, timestamp)
+}
+
 // AddReaction mocks base method.
-func (m *MockService) AddReaction(channelID, timestamp, name string) error {
+func (m *MockService) AddReaction(ctx context.Context, channelID, timestamp, name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddReaction", channelID, timestamp, name)
+	ret := m.ctrl.Call(m, "AddReaction", ctx, channelID, timestamp, name)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddReaction indicates an expected call of AddReaction.
-func (mr *MockServiceMockRecorder) AddReaction(channelID, timestamp, name any) *gomock.Call {
+func (mr *MockServiceMockRecorder) AddReaction(ctx, channelID, timestamp, name any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockService)(nil).AddReaction), channelID, timestamp, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockService)(nil).AddReaction), ctx, channelID, timestamp, name)
+}
+
+// AddReminder mocks base method.
+func (m *MockService) AddReminder(ctx context.Context, params slack.AddReminderParams) (*slack.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReminder", ctx, params)
+	ret0, _ := ret[0].(*slack.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReminder indicates an expected call of AddReminder.
+func (mr *MockServiceMockRecorder) AddReminder(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReminder", reflect.TypeOf((*MockService)(nil).AddReminder), ctx, params)
 }
 
 // ArchiveChannel mocks base method.
-func (m *MockService) ArchiveChannel(channelID string) error {
+func (m *MockService) ArchiveChannel(ctx context.Context, channelID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ArchiveChannel", channelID)
+	ret := m.ctrl.Call(m, "ArchiveChannel", ctx, channelID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ArchiveChannel indicates an expected call of ArchiveChannel.
-func (mr *MockServiceMockRecorder) ArchiveChannel(channelID any) *gomock.Call {
+func (mr *MockServiceMockRecorder) ArchiveChannel(ctx, channelID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveChannel", reflect.TypeOf((*MockService)(nil).ArchiveChannel), channelID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveChannel", reflect.TypeOf((*MockService)(nil).ArchiveChannel), ctx, channelID)
 }
 
 // AuthTest mocks base method.
-func (m *MockService) AuthTest() (*slack.AuthTestResult, error) {
+func (m *MockService) AuthTest(ctx context.Context) (*slack.AuthTestResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AuthTest")
+	ret := m.ctrl.Call(m, "AuthTest", ctx)
 	ret0, _ := ret[0].(*slack.AuthTestResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AuthTest indicates an expected call of AuthTest.
-func (mr *MockServiceMockRecorder) AuthTest() *gomock.Call {
+func (mr *MockServiceMockRecorder) AuthTest(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthTest", reflect.TypeOf((*MockService)(nil).AuthTest))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthTest", reflect.TypeOf((*MockService)(nil).AuthTest), ctx)
+}
+
+// CompleteReminder mocks base method.
+func (m *MockService) CompleteReminder(ctx context.Context, reminderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteReminder", ctx, reminderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteReminder indicates an expected call of CompleteReminder.
+func (mr *MockServiceMockRecorder) CompleteReminder(ctx, reminderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteReminder", reflect.TypeOf((*MockService)(nil).CompleteReminder), ctx, reminderID)
+}
+
+// ConvertChannel mocks base method.
+func (m *MockService) ConvertChannel(ctx context.Context, channelID string, private bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertChannel", ctx, channelID, private)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConvertChannel indicates an expected call of ConvertChannel.
+func (mr *MockServiceMockRecorder) ConvertChannel(ctx, channelID, private any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertChannel", reflect.TypeOf((*MockService)(nil).ConvertChannel), ctx, channelID, private)
 }
 
 // CreateChannel mocks base method.
-func (m *MockService) CreateChannel(name string, isPrivate bool) (*slack.Channel, error) {
+func (m *MockService) CreateChannel(ctx context.Context, name string, isPrivate bool) (*slack.Channel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateChannel", name, isPrivate)
+	ret := m.ctrl.Call(m, "CreateChannel", ctx, name, isPrivate)
 	ret0, _ := ret[0].(*slack.Channel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateChannel indicates an expected call of CreateChannel.
-func (mr *MockServiceMockRecorder) CreateChannel(name, isPrivate any) *gomock.Call {
+func (mr *MockServiceMockRecorder) CreateChannel(ctx, name, isPrivate any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannel", reflect.TypeOf((*MockService)(nil).CreateChannel), name, isPrivate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannel", reflect.TypeOf((*MockService)(nil).CreateChannel), ctx, name, isPrivate)
+}
+
+// CreateUserGroup mocks base method.
+func (m *MockService) CreateUserGroup(ctx context.Context, params slack.CreateUserGroupParams) (*slack.UserGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserGroup", ctx, params)
+	ret0, _ := ret[0].(*slack.UserGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserGroup indicates an expected call of CreateUserGroup.
+func (mr *MockServiceMockRecorder) CreateUserGroup(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserGroup", reflect.TypeOf((*MockService)(nil).CreateUserGroup), ctx, params)
 }
 
 // DeleteFile mocks base method.
-func (m *MockService) DeleteFile(fileID string) error {
+func (m *MockService) DeleteFile(ctx context.Context, fileID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteFile", fileID)
+	ret := m.ctrl.Call(m, "DeleteFile", ctx, fileID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteFile indicates an expected call of DeleteFile.
-func (mr *MockServiceMockRecorder) DeleteFile(fileID any) *gomock.Call {
+func (mr *MockServiceMockRecorder) DeleteFile(ctx, fileID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockService)(nil).DeleteFile), fileID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockService)(nil).DeleteFile), ctx, fileID)
 }
 
 // DeleteMessage mocks base method.
-func (m *MockService) DeleteMessage(channelID, timestamp string) error {
+func (m *MockService) DeleteMessage(ctx context.Context, channelID, timestamp string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMessage", channelID, timestamp)
+	ret := m.ctrl.Call(m, "DeleteMessage", ctx, channelID, timestamp)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteMessage indicates an expected call of DeleteMessage.
-func (mr *MockServiceMockRecorder) DeleteMessage(channelID, timestamp any) *gomock.Call {
+func (mr *MockServiceMockRecorder) DeleteMessage(ctx, channelID, timestamp any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockService)(nil).DeleteMessage), channelID, timestamp)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockService)(nil).DeleteMessage), ctx, channelID, timestamp)
+}
+
+// DeleteReminder mocks base method.
+func (m *MockService) DeleteReminder(ctx context.Context, reminderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReminder", ctx, reminderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReminder indicates an expected call of DeleteReminder.
+func (mr *MockServiceMockRecorder) DeleteReminder(ctx, reminderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReminder", reflect.TypeOf((*MockService)(nil).DeleteReminder), ctx, reminderID)
+}
+
+// DeleteScheduledMessage mocks base method.
+func (m *MockService) DeleteScheduledMessage(ctx context.Context, channelID, scheduledMessageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScheduledMessage", ctx, channelID, scheduledMessageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScheduledMessage indicates an expected call of DeleteScheduledMessage.
+func (mr *MockServiceMockRecorder) DeleteScheduledMessage(ctx, channelID, scheduledMessageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduledMessage", reflect.TypeOf((*MockService)(nil).DeleteScheduledMessage), ctx, channelID, scheduledMessageID)
+}
+
+// DisableUserGroup mocks base method.
+func (m *MockService) DisableUserGroup(ctx context.Context, userGroupID string) (*slack.UserGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableUserGroup", ctx, userGroupID)
+	ret0, _ := ret[0].(*slack.UserGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableUserGroup indicates an expected call of DisableUserGroup.
+func (mr *MockServiceMockRecorder) DisableUserGroup(ctx, userGroupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableUserGroup", reflect.TypeOf((*MockService)(nil).DisableUserGroup), ctx, userGroupID)
 }
 
 // DownloadFile mocks base method.
-func (m *MockService) DownloadFile(url, destPath string) error {
+func (m *MockService) DownloadFile(ctx context.Context, url, destPath string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DownloadFile", url, destPath)
+	ret := m.ctrl.Call(m, "DownloadFile", ctx, url, destPath)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DownloadFile indicates an expected call of DownloadFile.
-func (mr *MockServiceMockRecorder) DownloadFile(url, destPath any) *gomock.Call {
+func (mr *MockServiceMockRecorder) DownloadFile(ctx, url, destPath any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadFile", reflect.TypeOf((*MockService)(nil).DownloadFile), ctx, url, destPath)
+}
+
+// EditBookmark mocks base method.
+func (m *MockService) EditBookmark(ctx context.Context, params slack.EditBookmarkParams) (*slack.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditBookmark", ctx, params)
+	ret0, _ := ret[0].(*slack.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditBookmark indicates an expected call of EditBookmark.
+func (mr *MockServiceMockRecorder) EditBookmark(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadFile", reflect.TypeOf((*MockService)(nil).DownloadFile), url, destPath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditBookmark", reflect.TypeOf((*MockService)(nil).EditBookmark), ctx, params)
 }
 
 // EditMessage mocks base method.
-func (m *MockService) EditMessage(channelID, timestamp, text string) (*slack.Message, error) {
+func (m *MockService) EditMessage(ctx context.Context, params slack.EditMessageParams) (*slack.Message, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EditMessage", channelID, timestamp, text)
+	ret := m.ctrl.Call(m, "EditMessage", ctx, params)
 	ret0, _ := ret[0].(*slack.Message)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EditMessage indicates an expected call of EditMessage.
-func (mr *MockServiceMockRecorder) EditMessage(channelID, timestamp, text any) *gomock.Call {
+func (mr *MockServiceMockRecorder) EditMessage(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditMessage", reflect.TypeOf((*MockService)(nil).EditMessage), ctx, params)
+}
+
+// EnableUserGroup mocks base method.
+func (m *MockService) EnableUserGroup(ctx context.Context, userGroupID string) (*slack.UserGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableUserGroup", ctx, userGroupID)
+	ret0, _ := ret[0].(*slack.UserGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableUserGroup indicates an expected call of EnableUserGroup.
+func (mr *MockServiceMockRecorder) EnableUserGroup(ctx, userGroupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableUserGroup", reflect.TypeOf((*MockService)(nil).EnableUserGroup), ctx, userGroupID)
+}
+
+// EndSnooze mocks base method.
+func (m *MockService) EndSnooze(ctx context.Context) (*slack.DNDStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndSnooze", ctx)
+	ret0, _ := ret[0].(*slack.DNDStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EndSnooze indicates an expected call of EndSnooze.
+func (mr *MockServiceMockRecorder) EndSnooze(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditMessage", reflect.TypeOf((*MockService)(nil).EditMessage), channelID, timestamp, text)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndSnooze", reflect.TypeOf((*MockService)(nil).EndSnooze), ctx)
 }
 
 // GetChannelInfo mocks base method.
-func (m *MockService) GetChannelInfo(channelID string) (*slack.Channel, error) {
+func (m *MockService) GetChannelInfo(ctx context.Context, channelID string) (*slack.Channel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetChannelInfo", channelID)
+	ret := m.ctrl.Call(m, "GetChannelInfo", ctx, channelID)
 	ret0, _ := ret[0].(*slack.Channel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetChannelInfo indicates an expected call of GetChannelInfo.
-func (mr *MockServiceMockRecorder) GetChannelInfo(channelID any) *gomock.Call {
+func (mr *MockServiceMockRecorder) GetChannelInfo(ctx, channelID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelInfo", reflect.TypeOf((*MockService)(nil).GetChannelInfo), channelID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelInfo", reflect.TypeOf((*MockService)(nil).GetChannelInfo), ctx, channelID)
+}
+
+// GetDNDInfo mocks base method.
+func (m *MockService) GetDNDInfo(ctx context.Context, userID string) (*slack.DNDStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDNDInfo", ctx, userID)
+	ret0, _ := ret[0].(*slack.DNDStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDNDInfo indicates an expected call of GetDNDInfo.
+func (mr *MockServiceMockRecorder) GetDNDInfo(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDNDInfo", reflect.TypeOf((*MockService)(nil).GetDNDInfo), ctx, userID)
 }
 
 // GetFileInfo mocks base method.
-func (m *MockService) GetFileInfo(fileID string) (*slack.File, error) {
+func (m *MockService) GetFileInfo(ctx context.Context, fileID string) (*slack.File, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFileInfo", fileID)
+	ret := m.ctrl.Call(m, "GetFileInfo", ctx, fileID)
 	ret0, _ := ret[0].(*slack.File)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetFileInfo indicates an expected call of GetFileInfo.
-func (mr *MockServiceMockRecorder) GetFileInfo(fileID any) *gomock.Call {
+func (mr *MockServiceMockRecorder) GetFileInfo(ctx, fileID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileInfo", reflect.TypeOf((*MockService)(nil).GetFileInfo), ctx, fileID)
+}
+
+// GetMessage mocks base method.
+func (m *MockService) GetMessage(ctx context.Context, params slack.GetMessageParams) (*slack.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessage", ctx, params)
+	ret0, _ := ret[0].(*slack.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessage indicates an expected call of GetMessage.
+func (mr *MockServiceMockRecorder) GetMessage(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileInfo", reflect.TypeOf((*MockService)(nil).GetFileInfo), fileID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessage", reflect.TypeOf((*MockService)(nil).GetMessage), ctx, params)
+}
+
+// GetMessageByPermalink mocks base method.
+func (m *MockService) GetMessageByPermalink(ctx context.Context, link string) (*slack.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageByPermalink", ctx, link)
+	ret0, _ := ret[0].(*slack.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageByPermalink indicates an expected call of GetMessageByPermalink.
+func (mr *MockServiceMockRecorder) GetMessageByPermalink(ctx, link any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageByPermalink", reflect.TypeOf((*MockService)(nil).GetMessageByPermalink), ctx, link)
+}
+
+// GetPermalink mocks base method.
+func (m *MockService) GetPermalink(ctx context.Context, channelID, timestamp string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPermalink", ctx, channelID, timestamp)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPermalink indicates an expected call of GetPermalink.
+func (mr *MockServiceMockRecorder) GetPermalink(ctx, channelID, timestamp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermalink", reflect.TypeOf((*MockService)(nil).GetPermalink), ctx, channelID, timestamp)
+}
+
+// GetReminderInfo mocks base method.
+func (m *MockService) GetReminderInfo(ctx context.Context, reminderID string) (*slack.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminderInfo", ctx, reminderID)
+	ret0, _ := ret[0].(*slack.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminderInfo indicates an expected call of GetReminderInfo.
+func (mr *MockServiceMockRecorder) GetReminderInfo(ctx, reminderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminderInfo", reflect.TypeOf((*MockService)(nil).GetReminderInfo), ctx, reminderID)
+}
+
+// GetThread mocks base method.
+func (m *MockService) GetThread(ctx context.Context, params slack.GetThreadParams) (*slack.PaginatedResult[slack.Message], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThread", ctx, params)
+	ret0, _ := ret[0].(*slack.PaginatedResult[slack.Message])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetThread indicates an expected call of GetThread.
+func (mr *MockServiceMockRecorder) GetThread(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThread", reflect.TypeOf((*MockService)(nil).GetThread), ctx, params)
 }
 
 // GetUserInfo mocks base method.
-func (m *MockService) GetUserInfo(userID string) (*slack.User, error) {
+func (m *MockService) GetUserInfo(ctx context.Context, userID string) (*slack.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserInfo", userID)
+	ret := m.ctrl.Call(m, "GetUserInfo", ctx, userID)
 	ret0, _ := ret[0].(*slack.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserInfo indicates an expected call of GetUserInfo.
-func (mr *MockServiceMockRecorder) GetUserInfo(userID any) *gomock.Call {
+func (mr *MockServiceMockRecorder) GetUserInfo(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockService)(nil).GetUserInfo), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockService)(nil).GetUserInfo), ctx, userID)
 }
 
 // GetUserPresence mocks base method.
-func (m *MockService) GetUserPresence(userID string) (string, error) {
+func (m *MockService) GetUserPresence(ctx context.Context, userID string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserPresence", userID)
+	ret := m.ctrl.Call(m, "GetUserPresence", ctx, userID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserPresence indicates an expected call of GetUserPresence.
-func (mr *MockServiceMockRecorder) GetUserPresence(userID any) *gomock.Call {
+func (mr *MockServiceMockRecorder) GetUserPresence(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPresence", reflect.TypeOf((*MockService)(nil).GetUserPresence), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPresence", reflect.TypeOf((*MockService)(nil).GetUserPresence), ctx, userID)
+}
+
+// GetUsers mocks base method.
+func (m *MockService) GetUsers(ctx context.Context, userIDs []string) ([]slack.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsers", ctx, userIDs)
+	ret0, _ := ret[0].([]slack.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsers indicates an expected call of GetUsers.
+func (mr *MockServiceMockRecorder) GetUsers(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockService)(nil).GetUsers), ctx, userIDs)
 }
 
 // InviteToChannel mocks base method.
-func (m *MockService) InviteToChannel(channelID string, userIDs ...string) error {
+func (m *MockService) InviteToChannel(ctx context.Context, channelID string, userIDs ...string) error {
 	m.ctrl.T.Helper()
-	varargs := []any{channelID}
+	varargs := []any{ctx, channelID}
 	for _, a := range userIDs {
 		varargs = append(varargs, a)
 	}
@@ -229,184 +512,671 @@ func (m *MockService) InviteToChannel(channelID string, userIDs ...string) error
 }
 
 // InviteToChannel indicates an expected call of InviteToChannel.
-func (mr *MockServiceMockRecorder) InviteToChannel(channelID any, userIDs ...any) *gomock.Call {
+func (mr *MockServiceMockRecorder) InviteToChannel(ctx, channelID any, userIDs ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{channelID}, userIDs...)
+	varargs := append([]any{ctx, channelID}, userIDs...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteToChannel", reflect.TypeOf((*MockService)(nil).InviteToChannel), varargs...)
 }
 
+// IterChannelMembers mocks base method.
+func (m *MockService) IterChannelMembers(ctx context.Context, params slack.ListChannelMembersParams) iter.Seq2[string, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterChannelMembers", ctx, params)
+	ret0, _ := ret[0].(iter.Seq2[string, error])
+	return ret0
+}
+
+// IterChannelMembers indicates an expected call of IterChannelMembers.
+func (mr *MockServiceMockRecorder) IterChannelMembers(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterChannelMembers", reflect.TypeOf((*MockService)(nil).IterChannelMembers), ctx, params)
+}
+
+// IterChannels mocks base method.
+func (m *MockService) IterChannels(ctx context.Context, params slack.ListChannelsParams) iter.Seq2[slack.Channel, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterChannels", ctx, params)
+	ret0, _ := ret[0].(iter.Seq2[slack.Channel, error])
+	return ret0
+}
+
+// IterChannels indicates an expected call of IterChannels.
+func (mr *MockServiceMockRecorder) IterChannels(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterChannels", reflect.TypeOf((*MockService)(nil).IterChannels), ctx, params)
+}
+
+// IterFiles mocks base method.
+func (m *MockService) IterFiles(ctx context.Context, params slack.PaginationParams, channelID, userID string) iter.Seq2[slack.File, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterFiles", ctx, params, channelID, userID)
+	ret0, _ := ret[0].(iter.Seq2[slack.File, error])
+	return ret0
+}
+
+// IterFiles indicates an expected call of IterFiles.
+func (mr *MockServiceMockRecorder) IterFiles(ctx, params, channelID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterFiles", reflect.TypeOf((*MockService)(nil).IterFiles), ctx, params, channelID, userID)
+}
+
+// IterMessages mocks base method.
+func (m *MockService) IterMessages(ctx context.Context, params slack.ListMessagesParams) iter.Seq2[slack.Message, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterMessages", ctx, params)
+	ret0, _ := ret[0].(iter.Seq2[slack.Message, error])
+	return ret0
+}
+
+// IterMessages indicates an expected call of IterMessages.
+func (mr *MockServiceMockRecorder) IterMessages(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterMessages", reflect.TypeOf((*MockService)(nil).IterMessages), ctx, params)
+}
+
+// IterReactions mocks base method.
+func (m *MockService) IterReactions(ctx context.Context, userID string, params slack.PaginationParams) iter.Seq2[slack.ReactedItem, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterReactions", ctx, userID, params)
+	ret0, _ := ret[0].(iter.Seq2[slack.ReactedItem, error])
+	return ret0
+}
+
+// IterReactions indicates an expected call of IterReactions.
+func (mr *MockServiceMockRecorder) IterReactions(ctx, userID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterReactions", reflect.TypeOf((*MockService)(nil).IterReactions), ctx, userID, params)
+}
+
+// IterScheduledMessages mocks base method.
+func (m *MockService) IterScheduledMessages(ctx context.Context, params slack.ListScheduledMessagesParams) iter.Seq2[slack.ScheduledMessage, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterScheduledMessages", ctx, params)
+	ret0, _ := ret[0].(iter.Seq2[slack.ScheduledMessage, error])
+	return ret0
+}
+
+// IterScheduledMessages indicates an expected call of IterScheduledMessages.
+func (mr *MockServiceMockRecorder) IterScheduledMessages(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterScheduledMessages", reflect.TypeOf((*MockService)(nil).IterScheduledMessages), ctx, params)
+}
+
+// IterThread mocks base method.
+func (m *MockService) IterThread(ctx context.Context, params slack.GetThreadParams) iter.Seq2[slack.Message, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterThread", ctx, params)
+	ret0, _ := ret[0].(iter.Seq2[slack.Message, error])
+	return ret0
+}
+
+// IterThread indicates an expected call of IterThread.
+func (mr *MockServiceMockRecorder) IterThread(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterThread", reflect.TypeOf((*MockService)(nil).IterThread), ctx, params)
+}
+
+// IterUsers mocks base method.
+func (m *MockService) IterUsers(ctx context.Context, params slack.PaginationParams) iter.Seq2[slack.User, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterUsers", ctx, params)
+	ret0, _ := ret[0].(iter.Seq2[slack.User, error])
+	return ret0
+}
+
+// IterUsers indicates an expected call of IterUsers.
+func (mr *MockServiceMockRecorder) IterUsers(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterUsers", reflect.TypeOf((*MockService)(nil).IterUsers), ctx, params)
+}
+
+// JoinChannel mocks base method.
+func (m *MockService) JoinChannel(ctx context.Context, channelID string) (*slack.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinChannel", ctx, channelID)
+	ret0, _ := ret[0].(*slack.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinChannel indicates an expected call of JoinChannel.
+func (mr *MockServiceMockRecorder) JoinChannel(ctx, channelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChannel", reflect.TypeOf((*MockService)(nil).JoinChannel), ctx, channelID)
+}
+
 // KickFromChannel mocks base method.
-func (m *MockService) KickFromChannel(channelID, userID string) error {
+func (m *MockService) KickFromChannel(ctx context.Context, channelID, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "KickFromChannel", channelID, userID)
+	ret := m.ctrl.Call(m, "KickFromChannel", ctx, channelID, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // KickFromChannel indicates an expected call of KickFromChannel.
-func (mr *MockServiceMockRecorder) KickFromChannel(channelID, userID any) *gomock.Call {
+func (mr *MockServiceMockRecorder) KickFromChannel(ctx, channelID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KickFromChannel", reflect.TypeOf((*MockService)(nil).KickFromChannel), ctx, channelID, userID)
+}
+
+// LeaveChannel mocks base method.
+func (m *MockService) LeaveChannel(ctx context.Context, channelID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveChannel", ctx, channelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveChannel indicates an expected call of LeaveChannel.
+func (mr *MockServiceMockRecorder) LeaveChannel(ctx, channelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveChannel", reflect.TypeOf((*MockService)(nil).LeaveChannel), ctx, channelID)
+}
+
+// ListBookmarks mocks base method.
+func (m *MockService) ListBookmarks(ctx context.Context, channelID string) ([]slack.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBookmarks", ctx, channelID)
+	ret0, _ := ret[0].([]slack.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBookmarks indicates an expected call of ListBookmarks.
+func (mr *MockServiceMockRecorder) ListBookmarks(ctx, channelID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KickFromChannel", reflect.TypeOf((*MockService)(nil).KickFromChannel), channelID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookmarks", reflect.TypeOf((*MockService)(nil).ListBookmarks), ctx, channelID)
+}
+
+// ListChannelMembers mocks base method.
+func (m *MockService) ListChannelMembers(ctx context.Context, params slack.ListChannelMembersParams) (*slack.PaginatedResult[string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChannelMembers", ctx, params)
+	ret0, _ := ret[0].(*slack.PaginatedResult[string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChannelMembers indicates an expected call of ListChannelMembers.
+func (mr *MockServiceMockRecorder) ListChannelMembers(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChannelMembers", reflect.TypeOf((*MockService)(nil).ListChannelMembers), ctx, params)
 }
 
 // ListChannels mocks base method.
-func (m *MockService) ListChannels(params slack.PaginationParams) (*slack.PaginatedResult[slack.Channel], error) {
+func (m *MockService) ListChannels(ctx context.Context, params slack.ListChannelsParams) (*slack.PaginatedResult[slack.Channel], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListChannels", params)
+	ret := m.ctrl.Call(m, "ListChannels", ctx, params)
 	ret0, _ := ret[0].(*slack.PaginatedResult[slack.Channel])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListChannels indicates an expected call of ListChannels.
-func (mr *MockServiceMockRecorder) ListChannels(params any) *gomock.Call {
+func (mr *MockServiceMockRecorder) ListChannels(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChannels", reflect.TypeOf((*MockService)(nil).ListChannels), ctx, params)
+}
+
+// ListEmoji mocks base method.
+func (m *MockService) ListEmoji(ctx context.Context) ([]slack.Emoji, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEmoji", ctx)
+	ret0, _ := ret[0].([]slack.Emoji)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEmoji indicates an expected call of ListEmoji.
+func (mr *MockServiceMockRecorder) ListEmoji(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChannels", reflect.TypeOf((*MockService)(nil).ListChannels), params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmoji", reflect.TypeOf((*MockService)(nil).ListEmoji), ctx)
 }
 
 // ListFiles mocks base method.
-func (m *MockService) ListFiles(params slack.PaginationParams, channelID, userID string) (*slack.PaginatedResult[slack.File], error) {
+func (m *MockService) ListFiles(ctx context.Context, params slack.PaginationParams, channelID, userID string) (*slack.PaginatedResult[slack.File], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListFiles", params, channelID, userID)
+	ret := m.ctrl.Call(m, "ListFiles", ctx, params, channelID, userID)
 	ret0, _ := ret[0].(*slack.PaginatedResult[slack.File])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListFiles indicates an expected call of ListFiles.
-func (mr *MockServiceMockRecorder) ListFiles(params, channelID, userID any) *gomock.Call {
+func (mr *MockServiceMockRecorder) ListFiles(ctx, params, channelID, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockService)(nil).ListFiles), params, channelID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockService)(nil).ListFiles), ctx, params, channelID, userID)
 }
 
 // ListMessages mocks base method.
-func (m *MockService) ListMessages(params slack.ListMessagesParams) (*slack.PaginatedResult[slack.Message], error) {
+func (m *MockService) ListMessages(ctx context.Context, params slack.ListMessagesParams) (*slack.PaginatedResult[slack.Message], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListMessages", params)
+	ret := m.ctrl.Call(m, "ListMessages", ctx, params)
 	ret0, _ := ret[0].(*slack.PaginatedResult[slack.Message])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListMessages indicates an expected call of ListMessages.
-func (mr *MockServiceMockRecorder) ListMessages(params any) *gomock.Call {
+func (mr *MockServiceMockRecorder) ListMessages(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMessages", reflect.TypeOf((*MockService)(nil).ListMessages), params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMessages", reflect.TypeOf((*MockService)(nil).ListMessages), ctx, params)
+}
+
+// ListPins mocks base method.
+func (m *MockService) ListPins(ctx context.Context, channelID string) ([]slack.PinnedItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPins", ctx, channelID)
+	ret0, _ := ret[0].([]slack.PinnedItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPins indicates an expected call of ListPins.
+func (mr *MockServiceMockRecorder) ListPins(ctx, channelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPins", reflect.TypeOf((*MockService)(nil).ListPins), ctx, channelID)
 }
 
 // ListReactions mocks base method.
-func (m *MockService) ListReactions(userID string, params slack.PaginationParams) (*slack.PaginatedResult[slack.ReactedItem], error) {
+func (m *MockService) ListReactions(ctx context.Context, userID string, params slack.PaginationParams) (*slack.PaginatedResult[slack.ReactedItem], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListReactions", userID, params)
+	ret := m.ctrl.Call(m, "ListReactions", ctx, userID, params)
 	ret0, _ := ret[0].(*slack.PaginatedResult[slack.ReactedItem])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListReactions indicates an expected call of ListReactions.
-func (mr *MockServiceMockRecorder) ListReactions(userID, params any) *gomock.Call {
+func (mr *MockServiceMockRecorder) ListReactions(ctx, userID, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReactions", reflect.TypeOf((*MockService)(nil).ListReactions), userID, params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReactions", reflect.TypeOf((*MockService)(nil).ListReactions), ctx, userID, params)
+}
+
+// ListReminders mocks base method.
+func (m *MockService) ListReminders(ctx context.Context) ([]slack.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReminders", ctx)
+	ret0, _ := ret[0].([]slack.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReminders indicates an expected call of ListReminders.
+func (mr *MockServiceMockRecorder) ListReminders(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReminders", reflect.TypeOf((*MockService)(nil).ListReminders), ctx)
+}
+
+// ListScheduledMessages mocks base method.
+func (m *MockService) ListScheduledMessages(ctx context.Context, params slack.ListScheduledMessagesParams) (*slack.PaginatedResult[slack.ScheduledMessage], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduledMessages", ctx, params)
+	ret0, _ := ret[0].(*slack.PaginatedResult[slack.ScheduledMessage])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduledMessages indicates an expected call of ListScheduledMessages.
+func (mr *MockServiceMockRecorder) ListScheduledMessages(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduledMessages", reflect.TypeOf((*MockService)(nil).ListScheduledMessages), ctx, params)
+}
+
+// ListUserGroupMembers mocks base method.
+func (m *MockService) ListUserGroupMembers(ctx context.Context, userGroupID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserGroupMembers", ctx, userGroupID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserGroupMembers indicates an expected call of ListUserGroupMembers.
+func (mr *MockServiceMockRecorder) ListUserGroupMembers(ctx, userGroupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserGroupMembers", reflect.TypeOf((*MockService)(nil).ListUserGroupMembers), ctx, userGroupID)
+}
+
+// ListUserGroups mocks base method.
+func (m *MockService) ListUserGroups(ctx context.Context, params slack.ListUserGroupsParams) ([]slack.UserGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserGroups", ctx, params)
+	ret0, _ := ret[0].([]slack.UserGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserGroups indicates an expected call of ListUserGroups.
+func (mr *MockServiceMockRecorder) ListUserGroups(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserGroups", reflect.TypeOf((*MockService)(nil).ListUserGroups), ctx, params)
 }
 
 // ListUsers mocks base method.
-func (m *MockService) ListUsers(params slack.PaginationParams) (*slack.PaginatedResult[slack.User], error) {
+func (m *MockService) ListUsers(ctx context.Context, params slack.PaginationParams) (*slack.PaginatedResult[slack.User], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsers", params)
+	ret := m.ctrl.Call(m, "ListUsers", ctx, params)
 	ret0, _ := ret[0].(*slack.PaginatedResult[slack.User])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListUsers indicates an expected call of ListUsers.
-func (mr *MockServiceMockRecorder) ListUsers(params any) *gomock.Call {
+func (mr *MockServiceMockRecorder) ListUsers(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockService)(nil).ListUsers), ctx, params)
+}
+
+// LookupUserByEmail mocks base method.
+func (m *MockService) LookupUserByEmail(ctx context.Context, email string) (*slack.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupUserByEmail", ctx, email)
+	ret0, _ := ret[0].(*slack.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupUserByEmail indicates an expected call of LookupUserByEmail.
+func (mr *MockServiceMockRecorder) LookupUserByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupUserByEmail", reflect.TypeOf((*MockService)(nil).LookupUserByEmail), ctx, email)
+}
+
+// OpenConversation mocks base method.
+func (m *MockService) OpenConversation(ctx context.Context, userIDs ...string) (*slack.Channel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range userIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OpenConversation", varargs...)
+	ret0, _ := ret[0].(*slack.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenConversation indicates an expected call of OpenConversation.
+func (mr *MockServiceMockRecorder) OpenConversation(ctx any, userIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, userIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenConversation", reflect.TypeOf((*MockService)(nil).OpenConversation), varargs...)
+}
+
+// PostEphemeral mocks base method.
+func (m *MockService) PostEphemeral(ctx context.Context, params slack.PostEphemeralParams) (*slack.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostEphemeral", ctx, params)
+	ret0, _ := ret[0].(*slack.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostEphemeral indicates an expected call of PostEphemeral.
+func (mr *MockServiceMockRecorder) PostEphemeral(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockService)(nil).ListUsers), params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostEphemeral", reflect.TypeOf((*MockService)(nil).PostEphemeral), ctx, params)
+}
+
+// RemoveBookmark mocks base method.
+func (m *MockService) RemoveBookmark(ctx context.Context, channelID, bookmarkID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBookmark", ctx, channelID, bookmarkID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBookmark indicates an expected call of RemoveBookmark.
+func (mr *MockServiceMockRecorder) RemoveBookmark(ctx, channelID, bookmarkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBookmark", reflect.TypeOf((*MockService)(nil).RemoveBookmark), ctx, channelID, bookmarkID)
+}
+
+// RemovePin mocks base method.
+func (m *MockService) RemovePin(ctx context.Context, channelID, timestamp string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePin", ctx, channelID, timestamp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePin indicates an expected call of RemovePin.
+func (mr *MockServiceMockRecorder) RemovePin(ctx, channelID, timestamp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePin", reflect.TypeOf((*MockService)(nil).RemovePin), ctx, channelID, timestamp)
 }
 
 // RemoveReaction mocks base method.
-func (m *MockService) RemoveReaction(channelID, timestamp, name string) error {
+func (m *MockService) RemoveReaction(ctx context.Context, channelID, timestamp, name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveReaction", channelID, timestamp, name)
+	ret := m.ctrl.Call(m, "RemoveReaction", ctx, channelID, timestamp, name)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveReaction indicates an expected call of RemoveReaction.
-func (mr *MockServiceMockRecorder) RemoveReaction(channelID, timestamp, name any) *gomock.Call {
+func (mr *MockServiceMockRecorder) RemoveReaction(ctx, channelID, timestamp, name any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockService)(nil).RemoveReaction), channelID, timestamp, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockService)(nil).RemoveReaction), ctx, channelID, timestamp, name)
+}
+
+// RenameChannel mocks base method.
+func (m *MockService) RenameChannel(ctx context.Context, channelID, name string) (*slack.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameChannel", ctx, channelID, name)
+	ret0, _ := ret[0].(*slack.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameChannel indicates an expected call of RenameChannel.
+func (mr *MockServiceMockRecorder) RenameChannel(ctx, channelID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameChannel", reflect.TypeOf((*MockService)(nil).RenameChannel), ctx, channelID, name)
+}
+
+// ScheduleMessage mocks base method.
+func (m *MockService) ScheduleMessage(ctx context.Context, params slack.ScheduleMessageParams) (*slack.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleMessage", ctx, params)
+	ret0, _ := ret[0].(*slack.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleMessage indicates an expected call of ScheduleMessage.
+func (mr *MockServiceMockRecorder) ScheduleMessage(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleMessage", reflect.TypeOf((*MockService)(nil).ScheduleMessage), ctx, params)
+}
+
+// SearchAll mocks base method.
+func (m *MockService) SearchAll(ctx context.Context, params slack.SearchParams) (*slack.SearchAllResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAll", ctx, params)
+	ret0, _ := ret[0].(*slack.SearchAllResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAll indicates an expected call of SearchAll.
+func (mr *MockServiceMockRecorder) SearchAll(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAll", reflect.TypeOf((*MockService)(nil).SearchAll), ctx, params)
+}
+
+// SearchFiles mocks base method.
+func (m *MockService) SearchFiles(ctx context.Context, params slack.SearchParams) (*slack.SearchFilesResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFiles", ctx, params)
+	ret0, _ := ret[0].(*slack.SearchFilesResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchFiles indicates an expected call of SearchFiles.
+func (mr *MockServiceMockRecorder) SearchFiles(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFiles", reflect.TypeOf((*MockService)(nil).SearchFiles), ctx, params)
 }
 
 // SearchMessages mocks base method.
-func (m *MockService) SearchMessages(params slack.SearchParams) (*slack.SearchResult, error) {
+func (m *MockService) SearchMessages(ctx context.Context, params slack.SearchParams) (*slack.SearchResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchMessages", params)
+	ret := m.ctrl.Call(m, "SearchMessages", ctx, params)
 	ret0, _ := ret[0].(*slack.SearchResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchMessages indicates an expected call of SearchMessages.
-func (mr *MockServiceMockRecorder) SearchMessages(params any) *gomock.Call {
+func (mr *MockServiceMockRecorder) SearchMessages(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockService)(nil).SearchMessages), params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockService)(nil).SearchMessages), ctx, params)
 }
 
 // SendMessage mocks base method.
-func (m *MockService) SendMessage(params slack.SendMessageParams) (*slack.Message, error) {
+func (m *MockService) SendMessage(ctx context.Context, params slack.SendMessageParams) (*slack.Message, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendMessage", params)
+	ret := m.ctrl.Call(m, "SendMessage", ctx, params)
 	ret0, _ := ret[0].(*slack.Message)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SendMessage indicates an expected call of SendMessage.
-func (mr *MockServiceMockRecorder) SendMessage(params any) *gomock.Call {
+func (mr *MockServiceMockRecorder) SendMessage(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockService)(nil).SendMessage), params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockService)(nil).SendMessage), ctx, params)
 }
 
 // SetChannelPurpose mocks base method.
-func (m *MockService) SetChannelPurpose(channelID, purpose string) error {
+func (m *MockService) SetChannelPurpose(ctx context.Context, channelID, purpose string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetChannelPurpose", channelID, purpose)
+	ret := m.ctrl.Call(m, "SetChannelPurpose", ctx, channelID, purpose)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetChannelPurpose indicates an expected call of SetChannelPurpose.
-func (mr *MockServiceMockRecorder) SetChannelPurpose(channelID, purpose any) *gomock.Call {
+func (mr *MockServiceMockRecorder) SetChannelPurpose(ctx, channelID, purpose any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChannelPurpose", reflect.TypeOf((*MockService)(nil).SetChannelPurpose), channelID, purpose)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChannelPurpose", reflect.TypeOf((*MockService)(nil).SetChannelPurpose), ctx, channelID, purpose)
 }
 
 // SetChannelTopic mocks base method.
-func (m *MockService) SetChannelTopic(channelID, topic string) error {
+func (m *MockService) SetChannelTopic(ctx context.Context, channelID, topic string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetChannelTopic", channelID, topic)
+	ret := m.ctrl.Call(m, "SetChannelTopic", ctx, channelID, topic)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetChannelTopic indicates an expected call of SetChannelTopic.
-func (mr *MockServiceMockRecorder) SetChannelTopic(channelID, topic any) *gomock.Call {
+func (mr *MockServiceMockRecorder) SetChannelTopic(ctx, channelID, topic any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChannelTopic", reflect.TypeOf((*MockService)(nil).SetChannelTopic), ctx, channelID, topic)
+}
+
+// SetPresence mocks base method.
+func (m *MockService) SetPresence(ctx context.Context, presence string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPresence", ctx, presence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPresence indicates an expected call of SetPresence.
+func (mr *MockServiceMockRecorder) SetPresence(ctx, presence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPresence", reflect.TypeOf((*MockService)(nil).SetPresence), ctx, presence)
+}
+
+// SetSnooze mocks base method.
+func (m *MockService) SetSnooze(ctx context.Context, d time.Duration) (*slack.DNDStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSnooze", ctx, d)
+	ret0, _ := ret[0].(*slack.DNDStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSnooze indicates an expected call of SetSnooze.
+func (mr *MockServiceMockRecorder) SetSnooze(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSnooze", reflect.TypeOf((*MockService)(nil).SetSnooze), ctx, d)
+}
+
+// SetStatus mocks base method.
+func (m *MockService) SetStatus(ctx context.Context, params slack.SetStatusParams) (*slack.UserStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStatus", ctx, params)
+	ret0, _ := ret[0].(*slack.UserStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStatus indicates an expected call of SetStatus.
+func (mr *MockServiceMockRecorder) SetStatus(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStatus", reflect.TypeOf((*MockService)(nil).SetStatus), ctx, params)
+}
+
+// SetUserGroupMembers mocks base method.
+func (m *MockService) SetUserGroupMembers(ctx context.Context, userGroupID string, userIDs []string) (*slack.UserGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserGroupMembers", ctx, userGroupID, userIDs)
+	ret0, _ := ret[0].(*slack.UserGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserGroupMembers indicates an expected call of SetUserGroupMembers.
+func (mr *MockServiceMockRecorder) SetUserGroupMembers(ctx, userGroupID, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserGroupMembers", reflect.TypeOf((*MockService)(nil).SetUserGroupMembers), ctx, userGroupID, userIDs)
+}
+
+// UnarchiveChannel mocks base method.
+func (m *MockService) UnarchiveChannel(ctx context.Context, channelID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveChannel", ctx, channelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnarchiveChannel indicates an expected call of UnarchiveChannel.
+func (mr *MockServiceMockRecorder) UnarchiveChannel(ctx, channelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveChannel", reflect.TypeOf((*MockService)(nil).UnarchiveChannel), ctx, channelID)
+}
+
+// UpdateUserGroup mocks base method.
+func (m *MockService) UpdateUserGroup(ctx context.Context, params slack.UpdateUserGroupParams) (*slack.UserGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserGroup", ctx, params)
+	ret0, _ := ret[0].(*slack.UserGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserGroup indicates an expected call of UpdateUserGroup.
+func (mr *MockServiceMockRecorder) UpdateUserGroup(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChannelTopic", reflect.TypeOf((*MockService)(nil).SetChannelTopic), channelID, topic)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserGroup", reflect.TypeOf((*MockService)(nil).UpdateUserGroup), ctx, params)
 }
 
 // UploadFile mocks base method.
-func (m *MockService) UploadFile(channelID, filename, title string, reader io.Reader) (*slack.File, error) {
+func (m *MockService) UploadFile(ctx context.Context, channelID, filename, title string, reader io.Reader) (*slack.File, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadFile", channelID, filename, title, reader)
+	ret := m.ctrl.Call(m, "UploadFile", ctx, channelID, filename, title, reader)
 	ret0, _ := ret[0].(*slack.File)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UploadFile indicates an expected call of UploadFile.
-func (mr *MockServiceMockRecorder) UploadFile(channelID, filename, title, reader any) *gomock.Call {
+func (mr *MockServiceMockRecorder) UploadFile(ctx, channelID, filename, title, reader any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFile", reflect.TypeOf((*MockService)(nil).UploadFile), channelID, filename, title, reader)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFile", reflect.TypeOf((*MockService)(nil).UploadFile), ctx, channelID, filename, title, reader)
 }
@@ -1,5 +1,7 @@
 package slack
 
+import "iter"
+
 type PaginationParams struct {
 	Cursor string
 	Limit  int
@@ -18,3 +20,40 @@ type PaginatedResult[T any] struct {
 	NextCursor string `json:"next_cursor,omitempty"`
 	HasMore    bool   `json:"has_more"`
 }
+
+// paginate yields the items of successive pages, starting at cursor, as
+// each page arrives. It stops after the last page, on the first error, or
+// when the consumer stops iterating.
+func paginate[T any](cursor string, page func(cursor string) (*PaginatedResult[T], error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			p, err := page(cursor)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range p.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if !p.HasMore {
+				return
+			}
+			cursor = p.NextCursor
+		}
+	}
+}
+
+// collect drains seq into a single result.
+func collect[T any](seq iter.Seq2[T, error]) (*PaginatedResult[T], error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return &PaginatedResult[T]{Items: items, HasMore: false}, nil
+}
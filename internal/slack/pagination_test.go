@@ -1,9 +1,11 @@
 package slack
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPaginationParams_EffectiveLimit(t *testing.T) {
@@ -26,3 +28,79 @@ func TestPaginationParams_EffectiveLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestPaginate(t *testing.T) {
+	pages := map[string]*PaginatedResult[int]{
+		"":   {Items: []int{1, 2}, NextCursor: "c1", HasMore: true},
+		"c1": {Items: []int{3}, NextCursor: "c2", HasMore: true},
+		"c2": {Items: []int{4, 5}},
+	}
+
+	t.Run("yields every page in order", func(t *testing.T) {
+		var cursors []string
+		seq := paginate("", func(cursor string) (*PaginatedResult[int], error) {
+			cursors = append(cursors, cursor)
+			return pages[cursor], nil
+		})
+
+		var got []int
+		for item, err := range seq {
+			require.NoError(t, err)
+			got = append(got, item)
+		}
+
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, got)
+		assert.Equal(t, []string{"", "c1", "c2"}, cursors)
+	})
+
+	t.Run("starts at the given cursor", func(t *testing.T) {
+		result, err := collect(paginate("c1", func(cursor string) (*PaginatedResult[int], error) {
+			return pages[cursor], nil
+		}))
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{3, 4, 5}, result.Items)
+		assert.False(t, result.HasMore)
+	})
+
+	t.Run("stops fetching when the consumer stops", func(t *testing.T) {
+		fetches := 0
+		seq := paginate("", func(cursor string) (*PaginatedResult[int], error) {
+			fetches++
+			return pages[cursor], nil
+		})
+
+		for item := range seq {
+			if item == 2 {
+				break
+			}
+		}
+
+		assert.Equal(t, 1, fetches)
+	})
+
+	t.Run("yields the page error and stops", func(t *testing.T) {
+		seq := paginate("", func(cursor string) (*PaginatedResult[int], error) {
+			if cursor == "c1" {
+				return nil, errors.New("boom")
+			}
+			return pages[cursor], nil
+		})
+
+		var got []int
+		var gotErr error
+		for item, err := range seq {
+			if err != nil {
+				gotErr = err
+				continue
+			}
+			got = append(got, item)
+		}
+
+		assert.Equal(t, []int{1, 2}, got)
+		require.EqualError(t, gotErr, "boom")
+
+		_, err := collect(seq)
+		require.EqualError(t, err, "boom")
+	})
+}
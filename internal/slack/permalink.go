@@ -0,0 +1,176 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	slackapi "github.com/slack-go/slack"
+)
+
+// PermalinkRef is the message a permalink points to. ThreadTS is set for
+// links to thread replies.
+type PermalinkRef struct {
+	ChannelID string `json:"channel"`
+	Timestamp string `json:"ts"`
+	ThreadTS  string `json:"thread_ts,omitempty"`
+}
+
+// ParsePermalink parses a message link such as
+// https://acme.slack.com/archives/C123/p1700000000123456?thread_ts=1700000000.000100.
+func ParsePermalink(link string) (*PermalinkRef, error) {
+	invalid := func(reason string) error {
+		return &SlackError{
+			Code:    ErrValidation,
+			Message: "invalid_permalink",
+			Detail:  fmt.Sprintf("%q: %s", link, reason),
+		}
+	}
+
+	u, err := url.Parse(strings.TrimSpace(link))
+	if err != nil {
+		return nil, invalid(err.Error())
+	}
+	host := strings.ToLower(u.Hostname())
+	if host != "slack.com" && !strings.HasSuffix(host, ".slack.com") {
+		return nil, invalid("not a slack.com link")
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) != 3 || parts[0] != "archives" || parts[1] == "" {
+		return nil, invalid("expected /archives/<channel>/p<timestamp>")
+	}
+	ts, err := permalinkTimestamp(parts[2])
+	if err != nil {
+		return nil, invalid(err.Error())
+	}
+
+	ref := &PermalinkRef{ChannelID: parts[1], Timestamp: ts}
+	if threadTS := u.Query().Get("thread_ts"); threadTS != "" && threadTS != ts {
+		ref.ThreadTS = threadTS
+	}
+	return ref, nil
+}
+
+// permalinkTimestamp turns a permalink's "p1700000000123456" path segment
+// into the message timestamp "1700000000.123456".
+func permalinkTimestamp(segment string) (string, error) {
+	digits, ok := strings.CutPrefix(segment, "p")
+	if !ok || len(digits) <= 6 {
+		return "", fmt.Errorf("malformed message id %q", segment)
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", fmt.Errorf("malformed message id %q", segment)
+		}
+	}
+	return digits[:len(digits)-6] + "." + digits[len(digits)-6:], nil
+}
+
+// GetPermalink returns the permalink for the message at timestamp in
+// channelID.
+func (c *Client) GetPermalink(ctx context.Context, channelID, timestamp string) (string, error) {
+	link, err := retry(ctx, c.endpoint("chat.getPermalink"), func() (string, error) {
+		return c.api.GetPermalinkContext(ctx, &slackapi.PermalinkParameters{Channel: channelID, Ts: timestamp})
+	})
+	if err != nil {
+		return "", classifyError(err)
+	}
+	return link, nil
+}
+
+// fillPermalinks sets Permalink on each message that has a channel and a
+// timestamp.
+func (c *Client) fillPermalinks(ctx context.Context, msgs []Message) error {
+	for i := range msgs {
+		if msgs[i].Channel == "" || msgs[i].Timestamp == "" {
+			continue
+		}
+		link, err := c.GetPermalink(ctx, msgs[i].Channel, msgs[i].Timestamp)
+		if err != nil {
+			return err
+		}
+		msgs[i].Permalink = link
+	}
+	return nil
+}
+
+// GetMessageParams identifies a single message. ThreadTS is required to
+// fetch a thread reply, which channel history does not include.
+type GetMessageParams struct {
+	ChannelID string
+	Timestamp string
+	ThreadTS  string
+}
+
+// GetMessage returns the message at params.Timestamp, or an ErrNotFound
+// error when there is none.
+func (c *Client) GetMessage(ctx context.Context, params GetMessageParams) (*Message, error) {
+	var msgs []slackapi.Message
+	var err error
+	if params.ThreadTS != "" && params.ThreadTS != params.Timestamp {
+		msgs, err = retry(ctx, c.endpoint("conversations.replies"), func() ([]slackapi.Message, error) {
+			// Replies always start with the thread's parent, so leave room
+			// for it alongside the requested reply.
+			msgs, _, _, err := c.api.GetConversationRepliesContext(ctx, &slackapi.GetConversationRepliesParameters{
+				ChannelID: params.ChannelID,
+				Timestamp: params.ThreadTS,
+				Oldest:    params.Timestamp,
+				Latest:    params.Timestamp,
+				Inclusive: true,
+				Limit:     2,
+			})
+			return msgs, err
+		})
+	} else {
+		msgs, err = retry(ctx, c.endpoint("conversations.history"), func() ([]slackapi.Message, error) {
+			resp, err := c.api.GetConversationHistoryContext(ctx, &slackapi.GetConversationHistoryParameters{
+				ChannelID: params.ChannelID,
+				Oldest:    params.Timestamp,
+				Latest:    params.Timestamp,
+				Inclusive: true,
+				Limit:     1,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return resp.Messages, nil
+		})
+	}
+	if err != nil {
+		return nil, classifyError(err)
+	}
+
+	for _, m := range msgs {
+		if m.Timestamp == params.Timestamp {
+			msg := messageFromAPI(m)
+			msg.Channel = params.ChannelID
+			return &msg, nil
+		}
+	}
+	return nil, &SlackError{
+		Code:    ErrNotFound,
+		Message: "message_not_found",
+		Detail:  fmt.Sprintf("no message at %s in %s", params.Timestamp, params.ChannelID),
+	}
+}
+
+// GetMessageByPermalink returns the message a permalink points to, with
+// Permalink set to the link.
+func (c *Client) GetMessageByPermalink(ctx context.Context, link string) (*Message, error) {
+	ref, err := ParsePermalink(link)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := c.GetMessage(ctx, GetMessageParams{
+		ChannelID: ref.ChannelID,
+		Timestamp: ref.Timestamp,
+		ThreadTS:  ref.ThreadTS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	msg.Permalink = strings.TrimSpace(link)
+	return msg, nil
+}
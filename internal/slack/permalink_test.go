@@ -0,0 +1,113 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePermalink(t *testing.T) {
+	tests := []struct {
+		name string
+		link string
+		want *PermalinkRef
+	}{
+		{
+			"top-level message",
+			"https://acme.slack.com/archives/C123ABC/p1700000000123456",
+			&PermalinkRef{ChannelID: "C123ABC", Timestamp: "1700000000.123456"},
+		},
+		{
+			"thread reply",
+			"https://acme.slack.com/archives/C123ABC/p1700000000123456?thread_ts=1700000000.000100&cid=C123ABC",
+			&PermalinkRef{ChannelID: "C123ABC", Timestamp: "1700000000.123456", ThreadTS: "1700000000.000100"},
+		},
+		{
+			"thread parent drops its own thread_ts",
+			"https://acme.slack.com/archives/C123ABC/p1700000000000100?thread_ts=1700000000.000100",
+			&PermalinkRef{ChannelID: "C123ABC", Timestamp: "1700000000.000100"},
+		},
+		{
+			"enterprise grid host",
+			"https://acme.enterprise.slack.com/archives/G123ABC/p1700000000123456",
+			&PermalinkRef{ChannelID: "G123ABC", Timestamp: "1700000000.123456"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePermalink(tt.link)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParsePermalink_Invalid(t *testing.T) {
+	links := []string{
+		"",
+		"https://example.com/archives/C123ABC/p1700000000123456",
+		"https://acme.slack.com/team/U123ABC",
+		"https://acme.slack.com/archives/C123ABC",
+		"https://acme.slack.com/archives/C123ABC/1700000000123456",
+		"https://acme.slack.com/archives/C123ABC/p17000x0000123456",
+		"https://acme.slack.com/archives/C123ABC/p123",
+	}
+
+	for _, link := range links {
+		t.Run(link, func(t *testing.T) {
+			_, err := ParsePermalink(link)
+
+			var se *SlackError
+			require.ErrorAs(t, err, &se)
+			assert.Equal(t, ErrValidation, se.Code)
+			assert.Equal(t, "invalid_permalink", se.Message)
+		})
+	}
+}
+
+func TestGetMessageByPermalink(t *testing.T) {
+	calls := map[string]int{}
+	checkBounds := func(r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "1700000000.123456", r.Form.Get("oldest"))
+		assert.Equal(t, "1700000000.123456", r.Form.Get("latest"))
+	}
+	c := newTestClient(t, counted(calls, map[string]http.HandlerFunc{
+		"conversations.replies": func(w http.ResponseWriter, r *http.Request) {
+			checkBounds(r)
+			assert.Equal(t, "1700000000.000100", r.Form.Get("ts"))
+			_, _ = w.Write([]byte(`{"ok":true,"messages":[` +
+				`{"type":"message","ts":"1700000000.000100","text":"parent"},` +
+				`{"type":"message","ts":"1700000000.123456","thread_ts":"1700000000.000100","user":"U1","text":"reply"}]}`))
+		},
+		"conversations.history": func(w http.ResponseWriter, r *http.Request) {
+			checkBounds(r)
+			_, _ = w.Write([]byte(`{"ok":true,"messages":[]}`))
+		},
+	}))
+
+	t.Run("thread reply", func(t *testing.T) {
+		link := "https://acme.slack.com/archives/C123/p1700000000123456?thread_ts=1700000000.000100"
+
+		msg, err := c.GetMessageByPermalink(context.Background(), link)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"conversations.replies": 1}, calls)
+		assert.Equal(t, "reply", msg.Text)
+		assert.Equal(t, "C123", msg.Channel)
+		assert.Equal(t, link, msg.Permalink)
+	})
+
+	t.Run("missing message", func(t *testing.T) {
+		_, err := c.GetMessageByPermalink(context.Background(), "https://acme.slack.com/archives/C123/p1700000000123456")
+
+		assert.Equal(t, map[string]int{"conversations.replies": 1, "conversations.history": 1}, calls)
+		var se *SlackError
+		require.ErrorAs(t, err, &se)
+		assert.Equal(t, ErrNotFound, se.Code)
+	})
+}
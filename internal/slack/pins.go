@@ -0,0 +1,80 @@
+package slack
+
+import (
+	"context"
+
+	slackapi "github.com/slack-go/slack"
+)
+
+// PinnedItem is a message or file pinned to a channel. Exactly one of
+// Message and File is set, according to Type.
+type PinnedItem struct {
+	Type    string   `json:"type"`
+	Channel string   `json:"channel"`
+	Message *Message `json:"message,omitempty"`
+	File    *File    `json:"file,omitempty"`
+}
+
+func pinnedItemFromAPI(item slackapi.Item, channelID string) PinnedItem {
+	pi := PinnedItem{
+		Type:    item.Type,
+		Channel: channelID,
+	}
+	if item.Message != nil {
+		msg := messageFromAPI(*item.Message)
+		msg.Channel = channelID
+		msg.Permalink = item.Message.Permalink
+		pi.Message = &msg
+	}
+	if item.File != nil {
+		f := fileFromAPI(*item.File)
+		pi.File = &f
+	}
+	return pi
+}
+
+func (c *Client) AddPin(ctx context.Context, channelID, timestamp string) error {
+	ref := slackapi.ItemRef{
+		Channel:   channelID,
+		Timestamp: timestamp,
+	}
+	_, err := retry(ctx, c.writeEndpoint("pins.add"), func() (struct{}, error) {
+		return struct{}{}, c.api.AddPinContext(ctx, channelID, ref)
+	})
+	if err != nil {
+		return classifyError(err)
+	}
+	return nil
+}
+
+func (c *Client) RemovePin(ctx context.Context, channelID, timestamp string) error {
+	ref := slackapi.ItemRef{
+		Channel:   channelID,
+		Timestamp: timestamp,
+	}
+	_, err := retry(ctx, c.writeEndpoint("pins.remove"), func() (struct{}, error) {
+		return struct{}{}, c.api.RemovePinContext(ctx, channelID, ref)
+	})
+	if err != nil {
+		return classifyError(err)
+	}
+	return nil
+}
+
+// ListPins returns every item pinned to a channel. pins.list is not
+// paginated.
+func (c *Client) ListPins(ctx context.Context, channelID string) ([]PinnedItem, error) {
+	items, err := retry(ctx, c.endpoint("pins.list"), func() ([]slackapi.Item, error) {
+		items, _, err := c.api.ListPinsContext(ctx, channelID)
+		return items, err
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+
+	result := make([]PinnedItem, len(items))
+	for i, item := range items {
+		result[i] = pinnedItemFromAPI(item, channelID)
+	}
+	return result, nil
+}
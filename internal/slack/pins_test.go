@@ -0,0 +1,47 @@
+package slack
+
+import (
+	"testing"
+
+	slackapi "github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPinnedItemFromAPI(t *testing.T) {
+	t.Run("message", func(t *testing.T) {
+		msg := &slackapi.Message{Msg: slackapi.Msg{
+			Type:      "message",
+			User:      "U123",
+			Text:      "runbook: restart the worker",
+			Timestamp: "1700000000.000100",
+			Permalink: "https://example.slack.com/archives/C123/p1700000000000100",
+		}}
+
+		got := pinnedItemFromAPI(slackapi.NewMessageItem("C123", msg), "C123")
+
+		assert.Equal(t, "message", got.Type)
+		assert.Equal(t, "C123", got.Channel)
+		assert.Nil(t, got.File)
+		require.NotNil(t, got.Message)
+		assert.Equal(t, Message{
+			Timestamp: "1700000000.000100",
+			User:      "U123",
+			Text:      "runbook: restart the worker",
+			Channel:   "C123",
+			Type:      "message",
+			Permalink: "https://example.slack.com/archives/C123/p1700000000000100",
+		}, *got.Message)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		file := &slackapi.File{ID: "F123", Name: "design.pdf"}
+
+		got := pinnedItemFromAPI(slackapi.NewFileItem(file), "C123")
+
+		assert.Equal(t, "file", got.Type)
+		assert.Nil(t, got.Message)
+		require.NotNil(t, got.File)
+		assert.Equal(t, "F123", got.File.ID)
+	})
+}
@@ -1,33 +1,102 @@
 package slack
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"math/rand/v2"
 	"time"
 
 	slackapi "github.com/slack-go/slack"
 )
 
-const maxRetries = 3
+// RetryPolicy controls how often a failed call is retried and how long
+// retry backs off between attempts.
+type RetryPolicy struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+	// RetryWrites also retries calls that change state, such as
+	// SendMessage, after a network failure. The first attempt may already
+	// have been applied, so this can produce duplicates.
+	RetryWrites bool
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  500 * time.Millisecond,
+	MaxDelay:   30 * time.Second,
+}
+
+// backoff returns the capped exponential delay before retry attempt+1,
+// with half of it randomised so concurrent callers spread out.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MaxDelay
+	if attempt < 32 {
+		if exp := p.BaseDelay << attempt; exp > 0 && (p.MaxDelay <= 0 || exp < p.MaxDelay) {
+			d = exp
+		}
+	}
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + rand.N(d-half+1)
+}
 
-func retry[T any](fn func() (T, error)) (T, error) {
+// retry calls fn until it succeeds, the policy gives up, or ctx is done.
+// Rate limits are always retried because Slack rejected the request; network
+// failures are retried only for reads unless the policy opts writes in.
+func retry[T any](ctx context.Context, ep endpoint, fn func() (T, error)) (T, error) {
 	var zero T
-	for attempt := 0; attempt <= maxRetries; attempt++ {
+	policy := ep.policy
+	for attempt := 0; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+		if err := ep.wait(ctx); err != nil {
+			return zero, err
+		}
 		result, err := fn()
 		if err == nil {
 			return result, nil
 		}
-		rateLimitErr, ok := err.(*slackapi.RateLimitedError)
-		if !ok {
+
+		var wait time.Duration
+		var rateLimitErr *slackapi.RateLimitedError
+		switch {
+		case errors.As(err, &rateLimitErr):
+			if attempt >= policy.MaxRetries {
+				return zero, fmt.Errorf("rate limited after %d retries: %w", policy.MaxRetries, err)
+			}
+			wait = rateLimitErr.RetryAfter
+			if wait == 0 {
+				wait = policy.backoff(attempt)
+			}
+			ep.pause(wait)
+		case isNetworkError(err) && (!ep.write || policy.RetryWrites):
+			if attempt >= policy.MaxRetries {
+				return zero, err
+			}
+			wait = policy.backoff(attempt)
+		default:
 			return zero, err
 		}
-		if attempt == maxRetries {
-			return zero, fmt.Errorf("rate limited after %d retries: %w", maxRetries, err)
-		}
-		wait := rateLimitErr.RetryAfter
-		if wait == 0 {
-			wait = time.Duration(attempt+1) * time.Second
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return zero, err
 		}
-		time.Sleep(wait)
 	}
-	return zero, fmt.Errorf("max retries exceeded")
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
@@ -1,6 +1,7 @@
 package slack
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -10,10 +11,16 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+var testEndpoint = endpoint{policy: RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  time.Millisecond,
+	MaxDelay:   time.Millisecond,
+}}
+
 func TestRetry(t *testing.T) {
 	t.Run("succeeds on first try", func(t *testing.T) {
 		calls := 0
-		result, err := retry(func() (string, error) {
+		result, err := retry(context.Background(), testEndpoint, func() (string, error) {
 			calls++
 			return "ok", nil
 		})
@@ -25,7 +32,7 @@ func TestRetry(t *testing.T) {
 
 	t.Run("succeeds after rate limit retries", func(t *testing.T) {
 		calls := 0
-		result, err := retry(func() (string, error) {
+		result, err := retry(context.Background(), testEndpoint, func() (string, error) {
 			calls++
 			if calls < 3 {
 				return "", &slackapi.RateLimitedError{RetryAfter: time.Millisecond}
@@ -40,7 +47,7 @@ func TestRetry(t *testing.T) {
 
 	t.Run("fails after max retries", func(t *testing.T) {
 		calls := 0
-		result, err := retry(func() (string, error) {
+		result, err := retry(context.Background(), testEndpoint, func() (string, error) {
 			calls++
 			return "", &slackapi.RateLimitedError{RetryAfter: time.Millisecond}
 		})
@@ -48,12 +55,12 @@ func TestRetry(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "rate limited after")
 		assert.Empty(t, result)
-		assert.Equal(t, maxRetries+1, calls)
+		assert.Equal(t, testEndpoint.policy.MaxRetries+1, calls)
 	})
 
 	t.Run("non-rate-limit error fails immediately", func(t *testing.T) {
 		calls := 0
-		result, err := retry(func() (string, error) {
+		result, err := retry(context.Background(), testEndpoint, func() (string, error) {
 			calls++
 			return "", errors.New("bad request")
 		})
@@ -64,11 +71,110 @@ func TestRetry(t *testing.T) {
 		assert.Equal(t, 1, calls)
 	})
 
+	t.Run("retries network errors on reads", func(t *testing.T) {
+		calls := 0
+		result, err := retry(context.Background(), testEndpoint, func() (string, error) {
+			calls++
+			if calls < 3 {
+				return "", slackapi.StatusCodeError{Code: 503, Status: "503 Service Unavailable"}
+			}
+			return "recovered", nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "recovered", result)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("network errors give up after max retries", func(t *testing.T) {
+		calls := 0
+		_, err := retry(context.Background(), testEndpoint, func() (string, error) {
+			calls++
+			return "", errors.New("internal_error")
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, "internal_error", err.Error())
+		assert.Equal(t, testEndpoint.policy.MaxRetries+1, calls)
+	})
+
+	t.Run("does not retry writes after network errors", func(t *testing.T) {
+		ep := testEndpoint
+		ep.write = true
+		calls := 0
+		_, err := retry(context.Background(), ep, func() (string, error) {
+			calls++
+			return "", errors.New("fatal_error")
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("retries writes when the policy opts in", func(t *testing.T) {
+		ep := testEndpoint
+		ep.write = true
+		ep.policy.RetryWrites = true
+		calls := 0
+		_, err := retry(context.Background(), ep, func() (string, error) {
+			calls++
+			if calls < 2 {
+				return "", errors.New("fatal_error")
+			}
+			return "ok", nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("retries writes after rate limits", func(t *testing.T) {
+		ep := testEndpoint
+		ep.write = true
+		calls := 0
+		_, err := retry(context.Background(), ep, func() (string, error) {
+			calls++
+			if calls < 2 {
+				return "", &slackapi.RateLimitedError{RetryAfter: time.Millisecond}
+			}
+			return "ok", nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("stops waiting when context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		_, err := retry(ctx, testEndpoint, func() (string, error) {
+			calls++
+			cancel()
+			return "", &slackapi.RateLimitedError{RetryAfter: time.Hour}
+		})
+
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("does not call fn with expired context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		calls := 0
+		_, err := retry(ctx, testEndpoint, func() (string, error) {
+			calls++
+			return "ok", nil
+		})
+
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Zero(t, calls)
+	})
+
 	t.Run("works with struct return type", func(t *testing.T) {
 		type data struct {
 			Value int
 		}
-		result, err := retry(func() (data, error) {
+		result, err := retry(context.Background(), testEndpoint, func() (data, error) {
 			return data{Value: 42}, nil
 		})
 
@@ -80,7 +186,7 @@ func TestRetry(t *testing.T) {
 		type data struct {
 			Value int
 		}
-		result, err := retry(func() (*data, error) {
+		result, err := retry(context.Background(), testEndpoint, func() (*data, error) {
 			return &data{Value: 99}, nil
 		})
 
@@ -89,3 +195,28 @@ func TestRetry(t *testing.T) {
 		assert.Equal(t, 99, result.Value)
 	})
 }
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	tests := []struct {
+		attempt int
+		full    time.Duration
+	}{
+		{0, 100 * time.Millisecond},
+		{1, 200 * time.Millisecond},
+		{3, 800 * time.Millisecond},
+		{4, time.Second},
+		{40, time.Second},
+	}
+
+	for _, tt := range tests {
+		for range 20 {
+			d := p.backoff(tt.attempt)
+			assert.GreaterOrEqual(t, d, tt.full/2)
+			assert.LessOrEqual(t, d, tt.full)
+		}
+	}
+
+	assert.Zero(t, RetryPolicy{}.backoff(2))
+}
@@ -0,0 +1,59 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	slackapi "github.com/slack-go/slack"
+)
+
+// apiResponse is satisfied by response structs embedding
+// slackapi.SlackResponse.
+type apiResponse interface {
+	Err() error
+}
+
+// postForm calls a Web API method that slack-go does not wrap and decodes
+// the response into out. Failures take the same shapes slack-go returns, so
+// retry and classifyError treat both alike, and with WithDebug the exchange
+// is logged as slack-go logs its own.
+func (c *Client) postForm(ctx context.Context, method string, values url.Values, out apiResponse) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiURL+method, strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if c.debug {
+		c.api.Debugf("POST %s%s %s", c.apiURL, method, values.Encode())
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if c.debug {
+		if dump, err := httputil.DumpResponse(resp, true); err == nil {
+			c.api.Debugln(string(dump))
+		}
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		secs, _ := strconv.Atoi(resp.Header.Get("Retry-After"))
+		return &slackapi.RateLimitedError{RetryAfter: time.Duration(secs) * time.Second}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return slackapi.StatusCodeError{Code: resp.StatusCode, Status: resp.Status}
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return err
+	}
+	return out.Err()
+}
@@ -0,0 +1,89 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"testing"
+	"time"
+
+	slackapi "github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostForm(t *testing.T) {
+	t.Run("decodes response", func(t *testing.T) {
+		c := newTestClient(t, map[string]http.HandlerFunc{
+			"reminders.info": func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Bearer xoxb-test", r.Header.Get("Authorization"))
+				assert.Equal(t, "Rm123", r.FormValue("reminder"))
+				_, _ = w.Write([]byte(`{"ok":true,"reminder":{"id":"Rm123","text":"check the deploy"}}`))
+			},
+		})
+
+		var resp reminderResponse
+		err := c.postForm(context.Background(), "reminders.info", map[string][]string{"reminder": {"Rm123"}}, &resp)
+
+		require.NoError(t, err)
+		assert.Equal(t, "check the deploy", resp.Reminder.Text)
+	})
+
+	t.Run("debug logs request and response", func(t *testing.T) {
+		c := newTestClient(t, map[string]http.HandlerFunc{
+			"reminders.info": respond(`{"ok":true,"reminder":{"id":"Rm123"}}`),
+		})
+		var logged bytes.Buffer
+		c.api = slackapi.New("xoxb-test", slackapi.OptionDebug(true), slackapi.OptionLog(log.New(&logged, "", 0)))
+		c.debug = true
+
+		var resp reminderResponse
+		require.NoError(t, c.postForm(context.Background(), "reminders.info", map[string][]string{"reminder": {"Rm123"}}, &resp))
+
+		assert.Equal(t, "Rm123", resp.Reminder.ID, "the body is still decoded after logging")
+		assert.Contains(t, logged.String(), "reminders.info reminder=Rm123")
+		assert.Contains(t, logged.String(), `"reminder":{"id":"Rm123"}`)
+		assert.NotContains(t, logged.String(), "xoxb-test")
+	})
+
+	t.Run("API error classifies like slack-go", func(t *testing.T) {
+		c := newTestClient(t, map[string]http.HandlerFunc{
+			"reminders.info": respond(`{"ok":false,"error":"not_found"}`),
+		})
+
+		var resp reminderResponse
+		err := c.postForm(context.Background(), "reminders.info", nil, &resp)
+
+		assert.Equal(t, ErrNotFound, classifyError(err).Code)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		c := newTestClient(t, map[string]http.HandlerFunc{
+			"reminders.complete": func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Retry-After", "3")
+				w.WriteHeader(http.StatusTooManyRequests)
+			},
+		})
+
+		var resp slackapi.SlackResponse
+		err := c.postForm(context.Background(), "reminders.complete", nil, &resp)
+
+		var rle *slackapi.RateLimitedError
+		require.ErrorAs(t, err, &rle)
+		assert.Equal(t, 3*time.Second, rle.RetryAfter)
+	})
+
+	t.Run("server error is transient", func(t *testing.T) {
+		c := newTestClient(t, map[string]http.HandlerFunc{
+			"reminders.complete": func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+			},
+		})
+
+		var resp slackapi.SlackResponse
+		err := c.postForm(context.Background(), "reminders.complete", nil, &resp)
+
+		assert.True(t, isNetworkError(err))
+	})
+}
@@ -1,6 +1,10 @@
 package slack
 
 import (
+	"context"
+	"iter"
+	"strconv"
+
 	slackapi "github.com/slack-go/slack"
 )
 
@@ -10,6 +14,10 @@ type Reaction struct {
 	Users []string `json:"users"`
 }
 
+func (r Reaction) String() string {
+	return ":" + r.Name + ": " + strconv.Itoa(r.Count)
+}
+
 type ReactedItem struct {
 	Type      string     `json:"type"`
 	Channel   string     `json:"channel,omitempty"`
@@ -17,13 +25,20 @@ type ReactedItem struct {
 	Reactions []Reaction `json:"reactions"`
 }
 
-func (c *Client) AddReaction(channelID, timestamp, name string) error {
+// AddReaction reacts to a message with the named emoji. The name may be
+// wrapped in colons and may carry a skin tone, as in "thumbsup::skin-tone-2";
+// unknown names are rejected before calling Slack.
+func (c *Client) AddReaction(ctx context.Context, channelID, timestamp, name string) error {
+	name = normalizeEmojiName(name)
+	if err := c.validateEmojiName(ctx, name); err != nil {
+		return err
+	}
 	ref := slackapi.ItemRef{
 		Channel:   channelID,
 		Timestamp: timestamp,
 	}
-	_, err := retry(func() (struct{}, error) {
-		return struct{}{}, c.api.AddReaction(name, ref)
+	_, err := retry(ctx, c.writeEndpoint("reactions.add"), func() (struct{}, error) {
+		return struct{}{}, c.api.AddReactionContext(ctx, name, ref)
 	})
 	if err != nil {
 		return classifyError(err)
@@ -31,13 +46,17 @@ func (c *Client) AddReaction(channelID, timestamp, name string) error {
 	return nil
 }
 
-func (c *Client) RemoveReaction(channelID, timestamp, name string) error {
+// RemoveReaction takes back a reaction. The name is normalized as in
+// AddReaction but not checked against the emoji list, so a reaction whose
+// custom emoji has since been deleted can still be removed.
+func (c *Client) RemoveReaction(ctx context.Context, channelID, timestamp, name string) error {
+	name = normalizeEmojiName(name)
 	ref := slackapi.ItemRef{
 		Channel:   channelID,
 		Timestamp: timestamp,
 	}
-	_, err := retry(func() (struct{}, error) {
-		return struct{}{}, c.api.RemoveReaction(name, ref)
+	_, err := retry(ctx, c.writeEndpoint("reactions.remove"), func() (struct{}, error) {
+		return struct{}{}, c.api.RemoveReactionContext(ctx, name, ref)
 	})
 	if err != nil {
 		return classifyError(err)
@@ -45,18 +64,18 @@ func (c *Client) RemoveReaction(channelID, timestamp, name string) error {
 	return nil
 }
 
-func (c *Client) ListReactions(userID string, params PaginationParams) (*PaginatedResult[ReactedItem], error) {
+func (c *Client) ListReactions(ctx context.Context, userID string, params PaginationParams) (*PaginatedResult[ReactedItem], error) {
 	if params.All {
-		return c.listAllReactions(userID, params)
+		return collect(c.IterReactions(ctx, userID, params))
 	}
-	return c.listReactionsPage(userID, params, "")
+	return c.listReactionsPage(ctx, userID, params)
 }
 
-func (c *Client) listReactionsPage(userID string, params PaginationParams, cursor string) (*PaginatedResult[ReactedItem], error) {
+func (c *Client) listReactionsPage(ctx context.Context, userID string, params PaginationParams) (*PaginatedResult[ReactedItem], error) {
 	listParams := slackapi.ListReactionsParameters{
 		User:   userID,
 		Limit:  params.EffectiveLimit(),
-		Cursor: cursor,
+		Cursor: params.Cursor,
 		Full:   true,
 	}
 
@@ -65,8 +84,8 @@ func (c *Client) listReactionsPage(userID string, params PaginationParams, curso
 		nextCursor string
 	}
 
-	r, err := retry(func() (listResult, error) {
-		items, nextCursor, err := c.api.ListReactions(listParams)
+	r, err := retry(ctx, c.endpoint("reactions.list"), func() (listResult, error) {
+		items, nextCursor, err := c.api.ListReactionsContext(ctx, listParams)
 		return listResult{items, nextCursor}, err
 	})
 	if err != nil {
@@ -82,24 +101,12 @@ func (c *Client) listReactionsPage(userID string, params PaginationParams, curso
 	}, nil
 }
 
-func (c *Client) listAllReactions(userID string, params PaginationParams) (*PaginatedResult[ReactedItem], error) {
-	var allItems []ReactedItem
-	cursor := ""
-	for {
-		result, err := c.listReactionsPage(userID, params, cursor)
-		if err != nil {
-			return nil, err
-		}
-		allItems = append(allItems, result.Items...)
-		if !result.HasMore {
-			break
-		}
-		cursor = result.NextCursor
-	}
-	return &PaginatedResult[ReactedItem]{
-		Items:   allItems,
-		HasMore: false,
-	}, nil
+// IterReactions yields a user's reacted items page by page as they are
+// fetched, starting at params.Cursor.
+func (c *Client) IterReactions(ctx context.Context, userID string, params PaginationParams) iter.Seq2[ReactedItem, error] {
+	return paginate(params.Cursor, func(cursor string) (*PaginatedResult[ReactedItem], error) {
+		return c.listReactionsPage(ctx, userID, PaginationParams{Cursor: cursor, Limit: params.EffectiveLimit()})
+	})
 }
 
 func convertReactedItems(items []slackapi.ReactedItem) []ReactedItem {
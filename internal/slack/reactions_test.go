@@ -1,6 +1,8 @@
 package slack
 
 import (
+	"context"
+	"net/http"
 	"testing"
 
 	slackapi "github.com/slack-go/slack"
@@ -90,3 +92,21 @@ func TestConvertReactedItems(t *testing.T) {
 		assert.Nil(t, got[0].Reactions)
 	})
 }
+
+func TestRemoveReaction_SkipsEmojiValidation(t *testing.T) {
+	calls := map[string]int{}
+	var removed string
+	c := newTestClient(t, counted(calls, map[string]http.HandlerFunc{
+		"reactions.remove": func(w http.ResponseWriter, r *http.Request) {
+			removed = r.FormValue("name")
+			_, _ = w.Write([]byte(`{"ok":true}`))
+		},
+	}))
+
+	// deleted-parrot is in no emoji list, as for a custom emoji removed
+	// after the reaction was added.
+	require.NoError(t, c.RemoveReaction(context.Background(), "C123", "1700000000.000100", ":deleted-parrot:"))
+
+	assert.Equal(t, map[string]int{"reactions.remove": 1}, calls)
+	assert.Equal(t, "deleted-parrot", removed)
+}
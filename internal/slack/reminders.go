@@ -0,0 +1,133 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	slackapi "github.com/slack-go/slack"
+)
+
+type Reminder struct {
+	ID         string `json:"id"`
+	Creator    string `json:"creator"`
+	User       string `json:"user"`
+	Text       string `json:"text"`
+	Recurring  bool   `json:"recurring"`
+	Time       int64  `json:"time,omitempty"`
+	CompleteTS int64  `json:"complete_ts,omitempty"`
+}
+
+func reminderFromAPI(r slackapi.Reminder) Reminder {
+	return Reminder{
+		ID:         r.ID,
+		Creator:    r.Creator,
+		User:       r.User,
+		Text:       r.Text,
+		Recurring:  r.Recurring,
+		Time:       int64(r.Time),
+		CompleteTS: int64(r.CompleteTS),
+	}
+}
+
+// reminderResponse is the reminders.add and reminders.info response, for
+// the calls slack-go does not wrap.
+type reminderResponse struct {
+	slackapi.SlackResponse
+	Reminder slackapi.Reminder `json:"reminder"`
+}
+
+// AddReminderParams describes a reminder. Time is anything reminders.add
+// accepts: a Unix timestamp, natural language such as "in 2 hours" or
+// "every Thursday at 2pm", or additionally an RFC 3339 time.
+type AddReminderParams struct {
+	Text   string
+	Time   string
+	UserID string // defaults to the authenticated user
+}
+
+func (c *Client) AddReminder(ctx context.Context, params AddReminderParams) (*Reminder, error) {
+	when := reminderTime(params.Time)
+	r, err := retry(ctx, c.writeEndpoint("reminders.add"), func() (slackapi.Reminder, error) {
+		if params.UserID != "" {
+			r, err := c.api.AddUserReminderContext(ctx, params.UserID, params.Text, when)
+			if err != nil {
+				return slackapi.Reminder{}, err
+			}
+			return *r, nil
+		}
+		var resp reminderResponse
+		err := c.postForm(ctx, "reminders.add", url.Values{
+			"text": {params.Text},
+			"time": {when},
+		}, &resp)
+		return resp.Reminder, err
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	reminder := reminderFromAPI(r)
+	return &reminder, nil
+}
+
+// reminderTime converts an RFC 3339 time to the Unix timestamp Slack
+// expects and passes anything else through for Slack to parse.
+func reminderTime(s string) string {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return strconv.FormatInt(t.Unix(), 10)
+	}
+	return s
+}
+
+// ListReminders returns the reminders created by or for the authenticated
+// user. reminders.list is not paginated.
+func (c *Client) ListReminders(ctx context.Context) ([]Reminder, error) {
+	reminders, err := retry(ctx, c.endpoint("reminders.list"), func() ([]*slackapi.Reminder, error) {
+		return c.api.ListRemindersContext(ctx)
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+
+	result := make([]Reminder, len(reminders))
+	for i, r := range reminders {
+		result[i] = reminderFromAPI(*r)
+	}
+	return result, nil
+}
+
+func (c *Client) GetReminderInfo(ctx context.Context, reminderID string) (*Reminder, error) {
+	r, err := retry(ctx, c.endpoint("reminders.info"), func() (slackapi.Reminder, error) {
+		var resp reminderResponse
+		err := c.postForm(ctx, "reminders.info", url.Values{"reminder": {reminderID}}, &resp)
+		return resp.Reminder, err
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	reminder := reminderFromAPI(r)
+	return &reminder, nil
+}
+
+// CompleteReminder marks a one-off reminder as complete.
+func (c *Client) CompleteReminder(ctx context.Context, reminderID string) error {
+	_, err := retry(ctx, c.writeEndpoint("reminders.complete"), func() (struct{}, error) {
+		var resp slackapi.SlackResponse
+		return struct{}{}, c.postForm(ctx, "reminders.complete", url.Values{"reminder": {reminderID}}, &resp)
+	})
+	if err != nil {
+		return classifyError(err)
+	}
+	return nil
+}
+
+func (c *Client) DeleteReminder(ctx context.Context, reminderID string) error {
+	_, err := retry(ctx, c.writeEndpoint("reminders.delete"), func() (struct{}, error) {
+		return struct{}{}, c.api.DeleteReminderContext(ctx, reminderID)
+	})
+	if err != nil {
+		return classifyError(err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package slack
+
+import (
+	"testing"
+
+	slackapi "github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReminderFromAPI(t *testing.T) {
+	got := reminderFromAPI(slackapi.Reminder{
+		ID:         "Rm123",
+		Creator:    "U1",
+		User:       "U2",
+		Text:       "check the deploy",
+		Recurring:  false,
+		Time:       1700000000,
+		CompleteTS: 1700000500,
+	})
+
+	assert.Equal(t, Reminder{
+		ID:         "Rm123",
+		Creator:    "U1",
+		User:       "U2",
+		Text:       "check the deploy",
+		Time:       1700000000,
+		CompleteTS: 1700000500,
+	}, got)
+}
+
+func TestReminderTime(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"2023-11-14T22:13:20Z", "1700000000"},
+		{"1700000000", "1700000000"},
+		{"in 2 hours", "in 2 hours"},
+		{"every Thursday at 2pm", "every Thursday at 2pm"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, reminderTime(tt.input))
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	channelIDPattern = regexp.MustCompile(`^[CGD][A-Z0-9]{6,}$`)
+	userIDPattern    = regexp.MustCompile(`^[UW][A-Z0-9]{6,}$`)
+)
+
+// NameResolver turns the channel and user references people actually type
+// into IDs. Channels may be given as an ID, #general, general or a
+// <#C123|general> mention; users as an ID, @alice, alice, alice@example.com
+// or a <@U123> mention. IDs pass through without an API call.
+type NameResolver struct {
+	svc Service
+}
+
+func NewNameResolver(svc Service) *NameResolver {
+	return &NameResolver{svc: svc}
+}
+
+// ResolveChannel returns the ID of the channel ref names. An empty ref
+// resolves to "", so optional arguments can be passed straight through.
+func (r *NameResolver) ResolveChannel(ctx context.Context, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return "", nil
+	}
+	if id, ok := mentionID(ref, "<#"); ok {
+		return id, nil
+	}
+	if channelIDPattern.MatchString(ref) {
+		return ref, nil
+	}
+
+	name := strings.TrimPrefix(ref, "#")
+	var matches []Channel
+	for ch, err := range r.svc.IterChannels(ctx, ListChannelsParams{Pagination: PaginationParams{Limit: 1000}}) {
+		if err != nil {
+			return "", err
+		}
+		if strings.EqualFold(ch.Name, name) {
+			matches = append(matches, ch)
+		}
+	}
+	// Archived channels keep their names, so prefer the live one.
+	if len(matches) > 1 {
+		if active := filter(matches, func(ch Channel) bool { return !ch.IsArchived }); len(active) == 1 {
+			matches = active
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", &SlackError{Code: ErrNotFound, Message: "channel_not_found", Detail: fmt.Sprintf("no channel named %q", "#"+name)}
+	case 1:
+		return matches[0].ID, nil
+	}
+	candidates := make([]string, len(matches))
+	for i, ch := range matches {
+		candidates[i] = fmt.Sprintf("%s (#%s", ch.ID, ch.Name)
+		if ch.IsArchived {
+			candidates[i] += ", archived"
+		}
+		candidates[i] += ")"
+	}
+	return "", ambiguousError("#"+name, "channels", candidates)
+}
+
+// resolveChannels resolves each of refs as ResolveChannel does.
+func (r *NameResolver) resolveChannels(ctx context.Context, refs []string) ([]string, error) {
+	if len(refs) == 0 {
+		return refs, nil
+	}
+	ids := make([]string, len(refs))
+	for i, ref := range refs {
+		id, err := r.ResolveChannel(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// ResolveUser returns the ID of the user ref names. An empty ref resolves
+// to "".
+func (r *NameResolver) ResolveUser(ctx context.Context, ref string) (string, error) {
+	ids, err := r.ResolveUsers(ctx, []string{ref})
+	if err != nil {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// ResolveUsers resolves each of refs as ResolveUser does, listing the user
+// directory at most once however many names need it.
+func (r *NameResolver) ResolveUsers(ctx context.Context, refs []string) ([]string, error) {
+	ids := make([]string, len(refs))
+	var users []User
+	for i, ref := range refs {
+		ref = strings.TrimSpace(ref)
+		if id, ok := mentionID(ref, "<@"); ok {
+			ids[i] = id
+			continue
+		}
+		if ref == "" || userIDPattern.MatchString(ref) {
+			ids[i] = ref
+			continue
+		}
+		if !strings.HasPrefix(ref, "@") && strings.Contains(ref, "@") {
+			u, err := r.svc.LookupUserByEmail(ctx, ref)
+			if err != nil {
+				return nil, err
+			}
+			ids[i] = u.ID
+			continue
+		}
+
+		if users == nil {
+			var err error
+			if users, err = r.listUsers(ctx); err != nil {
+				return nil, err
+			}
+		}
+		id, err := matchUser(users, strings.TrimPrefix(ref, "@"))
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func (r *NameResolver) listUsers(ctx context.Context) ([]User, error) {
+	users := []User{}
+	for u, err := range r.svc.IterUsers(ctx, PaginationParams{Limit: 200}) {
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+// matchUser finds name among users' handles, falling back to real names.
+// Deactivated accounts only match when no active one does.
+func matchUser(users []User, name string) (string, error) {
+	matches := filter(users, func(u User) bool { return strings.EqualFold(u.Name, name) })
+	if len(matches) == 0 {
+		matches = filter(users, func(u User) bool { return strings.EqualFold(u.RealName, name) })
+	}
+	if len(matches) > 1 {
+		if active := filter(matches, func(u User) bool { return !u.Deleted }); len(active) > 0 {
+			matches = active
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", &SlackError{Code: ErrNotFound, Message: "user_not_found", Detail: fmt.Sprintf("no user named %q", "@"+name)}
+	case 1:
+		return matches[0].ID, nil
+	}
+	candidates := make([]string, len(matches))
+	for i, u := range matches {
+		candidates[i] = fmt.Sprintf("%s (@%s", u.ID, u.Name)
+		if u.RealName != "" {
+			candidates[i] += ", " + u.RealName
+		}
+		if u.Deleted {
+			candidates[i] += ", deactivated"
+		}
+		candidates[i] += ")"
+	}
+	return "", ambiguousError("@"+name, "users", candidates)
+}
+
+func ambiguousError(ref, kind string, candidates []string) *SlackError {
+	return &SlackError{
+		Code:    ErrValidation,
+		Message: "ambiguous_name",
+		Detail:  fmt.Sprintf("%q matches %d %s: %s; use an ID instead", ref, len(candidates), kind, strings.Join(candidates, ", ")),
+	}
+}
+
+// mentionID extracts the ID from a Slack mention such as <#C123|general>
+// or <@U123>.
+func mentionID(ref, prefix string) (string, bool) {
+	inner, ok := strings.CutPrefix(ref, prefix)
+	if !ok {
+		return "", false
+	}
+	inner, ok = strings.CutSuffix(inner, ">")
+	if !ok {
+		return "", false
+	}
+	id, _, _ := strings.Cut(inner, "|")
+	return id, id != ""
+}
+
+func filter[T any](items []T, keep func(T) bool) []T {
+	var kept []T
+	for _, item := range items {
+		if keep(item) {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,443 @@
+package slack
+
+import (
+	"context"
+	"io"
+	"iter"
+)
+
+// WithNameResolution wraps svc so that every channel and user argument
+// accepts the names NameResolver understands as well as IDs. Methods that
+// take neither pass straight through to svc.
+func WithNameResolution(svc Service) Service {
+	return &resolvingService{Service: svc, r: NewNameResolver(svc)}
+}
+
+type resolvingService struct {
+	Service
+	r *NameResolver
+}
+
+// errSeq is a sequence that yields only err.
+func errSeq[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}
+}
+
+func (s *resolvingService) GetChannelInfo(ctx context.Context, channelID string) (*Channel, error) {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return nil, err
+	}
+	return s.Service.GetChannelInfo(ctx, channelID)
+}
+
+func (s *resolvingService) ListChannelMembers(ctx context.Context, params ListChannelMembersParams) (*PaginatedResult[string], error) {
+	var err error
+	if params.ChannelID, err = s.r.ResolveChannel(ctx, params.ChannelID); err != nil {
+		return nil, err
+	}
+	return s.Service.ListChannelMembers(ctx, params)
+}
+
+func (s *resolvingService) IterChannelMembers(ctx context.Context, params ListChannelMembersParams) iter.Seq2[string, error] {
+	var err error
+	if params.ChannelID, err = s.r.ResolveChannel(ctx, params.ChannelID); err != nil {
+		return errSeq[string](err)
+	}
+	return s.Service.IterChannelMembers(ctx, params)
+}
+
+func (s *resolvingService) OpenConversation(ctx context.Context, userIDs ...string) (*Channel, error) {
+	var err error
+	if userIDs, err = s.r.ResolveUsers(ctx, userIDs); err != nil {
+		return nil, err
+	}
+	return s.Service.OpenConversation(ctx, userIDs...)
+}
+
+func (s *resolvingService) ArchiveChannel(ctx context.Context, channelID string) error {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return err
+	}
+	return s.Service.ArchiveChannel(ctx, channelID)
+}
+
+func (s *resolvingService) UnarchiveChannel(ctx context.Context, channelID string) error {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return err
+	}
+	return s.Service.UnarchiveChannel(ctx, channelID)
+}
+
+func (s *resolvingService) JoinChannel(ctx context.Context, channelID string) (*Channel, error) {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return nil, err
+	}
+	return s.Service.JoinChannel(ctx, channelID)
+}
+
+func (s *resolvingService) LeaveChannel(ctx context.Context, channelID string) error {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return err
+	}
+	return s.Service.LeaveChannel(ctx, channelID)
+}
+
+func (s *resolvingService) RenameChannel(ctx context.Context, channelID, name string) (*Channel, error) {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return nil, err
+	}
+	return s.Service.RenameChannel(ctx, channelID, name)
+}
+
+func (s *resolvingService) ConvertChannel(ctx context.Context, channelID string, private bool) error {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return err
+	}
+	return s.Service.ConvertChannel(ctx, channelID, private)
+}
+
+func (s *resolvingService) InviteToChannel(ctx context.Context, channelID string, userIDs ...string) error {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return err
+	}
+	if userIDs, err = s.r.ResolveUsers(ctx, userIDs); err != nil {
+		return err
+	}
+	// A name and an ID may resolve to the same user.
+	return s.Service.InviteToChannel(ctx, channelID, uniqueIDs(userIDs)...)
+}
+
+func (s *resolvingService) KickFromChannel(ctx context.Context, channelID, userID string) error {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return err
+	}
+	if userID, err = s.r.ResolveUser(ctx, userID); err != nil {
+		return err
+	}
+	return s.Service.KickFromChannel(ctx, channelID, userID)
+}
+
+func (s *resolvingService) SetChannelTopic(ctx context.Context, channelID, topic string) error {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return err
+	}
+	return s.Service.SetChannelTopic(ctx, channelID, topic)
+}
+
+func (s *resolvingService) SetChannelPurpose(ctx context.Context, channelID, purpose string) error {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return err
+	}
+	return s.Service.SetChannelPurpose(ctx, channelID, purpose)
+}
+
+func (s *resolvingService) ListBookmarks(ctx context.Context, channelID string) ([]Bookmark, error) {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return nil, err
+	}
+	return s.Service.ListBookmarks(ctx, channelID)
+}
+
+func (s *resolvingService) AddBookmark(ctx context.Context, params AddBookmarkParams) (*Bookmark, error) {
+	var err error
+	if params.ChannelID, err = s.r.ResolveChannel(ctx, params.ChannelID); err != nil {
+		return nil, err
+	}
+	return s.Service.AddBookmark(ctx, params)
+}
+
+func (s *resolvingService) EditBookmark(ctx context.Context, params EditBookmarkParams) (*Bookmark, error) {
+	var err error
+	if params.ChannelID, err = s.r.ResolveChannel(ctx, params.ChannelID); err != nil {
+		return nil, err
+	}
+	return s.Service.EditBookmark(ctx, params)
+}
+
+func (s *resolvingService) RemoveBookmark(ctx context.Context, channelID, bookmarkID string) error {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return err
+	}
+	return s.Service.RemoveBookmark(ctx, channelID, bookmarkID)
+}
+
+func (s *resolvingService) ListMessages(ctx context.Context, params ListMessagesParams) (*PaginatedResult[Message], error) {
+	var err error
+	if params.ChannelID, err = s.r.ResolveChannel(ctx, params.ChannelID); err != nil {
+		return nil, err
+	}
+	return s.Service.ListMessages(ctx, params)
+}
+
+func (s *resolvingService) IterMessages(ctx context.Context, params ListMessagesParams) iter.Seq2[Message, error] {
+	var err error
+	if params.ChannelID, err = s.r.ResolveChannel(ctx, params.ChannelID); err != nil {
+		return errSeq[Message](err)
+	}
+	return s.Service.IterMessages(ctx, params)
+}
+
+func (s *resolvingService) GetThread(ctx context.Context, params GetThreadParams) (*PaginatedResult[Message], error) {
+	var err error
+	if params.ChannelID, err = s.r.ResolveChannel(ctx, params.ChannelID); err != nil {
+		return nil, err
+	}
+	return s.Service.GetThread(ctx, params)
+}
+
+func (s *resolvingService) IterThread(ctx context.Context, params GetThreadParams) iter.Seq2[Message, error] {
+	var err error
+	if params.ChannelID, err = s.r.ResolveChannel(ctx, params.ChannelID); err != nil {
+		return errSeq[Message](err)
+	}
+	return s.Service.IterThread(ctx, params)
+}
+
+func (s *resolvingService) SendMessage(ctx context.Context, params SendMessageParams) (*Message, error) {
+	var err error
+	if params.ChannelID, err = s.r.ResolveChannel(ctx, params.ChannelID); err != nil {
+		return nil, err
+	}
+	return s.Service.SendMessage(ctx, params)
+}
+
+func (s *resolvingService) PostEphemeral(ctx context.Context, params PostEphemeralParams) (*Message, error) {
+	var err error
+	if params.ChannelID, err = s.r.ResolveChannel(ctx, params.ChannelID); err != nil {
+		return nil, err
+	}
+	if params.UserID, err = s.r.ResolveUser(ctx, params.UserID); err != nil {
+		return nil, err
+	}
+	return s.Service.PostEphemeral(ctx, params)
+}
+
+func (s *resolvingService) EditMessage(ctx context.Context, params EditMessageParams) (*Message, error) {
+	var err error
+	if params.ChannelID, err = s.r.ResolveChannel(ctx, params.ChannelID); err != nil {
+		return nil, err
+	}
+	return s.Service.EditMessage(ctx, params)
+}
+
+func (s *resolvingService) DeleteMessage(ctx context.Context, channelID, timestamp string) error {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return err
+	}
+	return s.Service.DeleteMessage(ctx, channelID, timestamp)
+}
+
+func (s *resolvingService) GetMessage(ctx context.Context, params GetMessageParams) (*Message, error) {
+	var err error
+	if params.ChannelID, err = s.r.ResolveChannel(ctx, params.ChannelID); err != nil {
+		return nil, err
+	}
+	return s.Service.GetMessage(ctx, params)
+}
+
+func (s *resolvingService) GetPermalink(ctx context.Context, channelID, timestamp string) (string, error) {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return "", err
+	}
+	return s.Service.GetPermalink(ctx, channelID, timestamp)
+}
+
+func (s *resolvingService) ScheduleMessage(ctx context.Context, params ScheduleMessageParams) (*ScheduledMessage, error) {
+	var err error
+	if params.ChannelID, err = s.r.ResolveChannel(ctx, params.ChannelID); err != nil {
+		return nil, err
+	}
+	return s.Service.ScheduleMessage(ctx, params)
+}
+
+func (s *resolvingService) ListScheduledMessages(ctx context.Context, params ListScheduledMessagesParams) (*PaginatedResult[ScheduledMessage], error) {
+	var err error
+	if params.ChannelID, err = s.r.ResolveChannel(ctx, params.ChannelID); err != nil {
+		return nil, err
+	}
+	return s.Service.ListScheduledMessages(ctx, params)
+}
+
+func (s *resolvingService) IterScheduledMessages(ctx context.Context, params ListScheduledMessagesParams) iter.Seq2[ScheduledMessage, error] {
+	var err error
+	if params.ChannelID, err = s.r.ResolveChannel(ctx, params.ChannelID); err != nil {
+		return errSeq[ScheduledMessage](err)
+	}
+	return s.Service.IterScheduledMessages(ctx, params)
+}
+
+func (s *resolvingService) DeleteScheduledMessage(ctx context.Context, channelID, scheduledMessageID string) error {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return err
+	}
+	return s.Service.DeleteScheduledMessage(ctx, channelID, scheduledMessageID)
+}
+
+func (s *resolvingService) GetUserInfo(ctx context.Context, userID string) (*User, error) {
+	var err error
+	if userID, err = s.r.ResolveUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.Service.GetUserInfo(ctx, userID)
+}
+
+func (s *resolvingService) GetUsers(ctx context.Context, userIDs []string) ([]User, error) {
+	var err error
+	if userIDs, err = s.r.ResolveUsers(ctx, userIDs); err != nil {
+		return nil, err
+	}
+	return s.Service.GetUsers(ctx, userIDs)
+}
+
+func (s *resolvingService) GetUserPresence(ctx context.Context, userID string) (string, error) {
+	var err error
+	if userID, err = s.r.ResolveUser(ctx, userID); err != nil {
+		return "", err
+	}
+	return s.Service.GetUserPresence(ctx, userID)
+}
+
+func (s *resolvingService) GetDNDInfo(ctx context.Context, userID string) (*DNDStatus, error) {
+	var err error
+	if userID, err = s.r.ResolveUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.Service.GetDNDInfo(ctx, userID)
+}
+
+func (s *resolvingService) CreateUserGroup(ctx context.Context, params CreateUserGroupParams) (*UserGroup, error) {
+	var err error
+	if params.Channels, err = s.r.resolveChannels(ctx, params.Channels); err != nil {
+		return nil, err
+	}
+	return s.Service.CreateUserGroup(ctx, params)
+}
+
+func (s *resolvingService) UpdateUserGroup(ctx context.Context, params UpdateUserGroupParams) (*UserGroup, error) {
+	var err error
+	if params.Channels, err = s.r.resolveChannels(ctx, params.Channels); err != nil {
+		return nil, err
+	}
+	return s.Service.UpdateUserGroup(ctx, params)
+}
+
+func (s *resolvingService) SetUserGroupMembers(ctx context.Context, userGroupID string, userIDs []string) (*UserGroup, error) {
+	var err error
+	if userIDs, err = s.r.ResolveUsers(ctx, userIDs); err != nil {
+		return nil, err
+	}
+	return s.Service.SetUserGroupMembers(ctx, userGroupID, userIDs)
+}
+
+func (s *resolvingService) AddReaction(ctx context.Context, channelID, timestamp, name string) error {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return err
+	}
+	return s.Service.AddReaction(ctx, channelID, timestamp, name)
+}
+
+func (s *resolvingService) RemoveReaction(ctx context.Context, channelID, timestamp, name string) error {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return err
+	}
+	return s.Service.RemoveReaction(ctx, channelID, timestamp, name)
+}
+
+func (s *resolvingService) ListReactions(ctx context.Context, userID string, params PaginationParams) (*PaginatedResult[ReactedItem], error) {
+	var err error
+	if userID, err = s.r.ResolveUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.Service.ListReactions(ctx, userID, params)
+}
+
+func (s *resolvingService) IterReactions(ctx context.Context, userID string, params PaginationParams) iter.Seq2[ReactedItem, error] {
+	var err error
+	if userID, err = s.r.ResolveUser(ctx, userID); err != nil {
+		return errSeq[ReactedItem](err)
+	}
+	return s.Service.IterReactions(ctx, userID, params)
+}
+
+func (s *resolvingService) AddPin(ctx context.Context, channelID, timestamp string) error {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return err
+	}
+	return s.Service.AddPin(ctx, channelID, timestamp)
+}
+
+func (s *resolvingService) RemovePin(ctx context.Context, channelID, timestamp string) error {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return err
+	}
+	return s.Service.RemovePin(ctx, channelID, timestamp)
+}
+
+func (s *resolvingService) ListPins(ctx context.Context, channelID string) ([]PinnedItem, error) {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return nil, err
+	}
+	return s.Service.ListPins(ctx, channelID)
+}
+
+func (s *resolvingService) AddReminder(ctx context.Context, params AddReminderParams) (*Reminder, error) {
+	var err error
+	if params.UserID, err = s.r.ResolveUser(ctx, params.UserID); err != nil {
+		return nil, err
+	}
+	return s.Service.AddReminder(ctx, params)
+}
+
+func (s *resolvingService) ListFiles(ctx context.Context, params PaginationParams, channelID, userID string) (*PaginatedResult[File], error) {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return nil, err
+	}
+	if userID, err = s.r.ResolveUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.Service.ListFiles(ctx, params, channelID, userID)
+}
+
+func (s *resolvingService) IterFiles(ctx context.Context, params PaginationParams, channelID, userID string) iter.Seq2[File, error] {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return errSeq[File](err)
+	}
+	if userID, err = s.r.ResolveUser(ctx, userID); err != nil {
+		return errSeq[File](err)
+	}
+	return s.Service.IterFiles(ctx, params, channelID, userID)
+}
+
+func (s *resolvingService) UploadFile(ctx context.Context, channelID, filename, title string, reader io.Reader) (*File, error) {
+	var err error
+	if channelID, err = s.r.ResolveChannel(ctx, channelID); err != nil {
+		return nil, err
+	}
+	return s.Service.UploadFile(ctx, channelID, filename, title, reader)
+}
@@ -0,0 +1,163 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newResolveTestClient serves a small workspace directory, counting calls
+// per method.
+func newResolveTestClient(t *testing.T, calls map[string]int) *Client {
+	return newTestClient(t, counted(calls, map[string]http.HandlerFunc{
+		"conversations.list": respond(`{"ok":true,"channels":[` +
+			`{"id":"C0000001","name":"general"},` +
+			`{"id":"C0000002","name":"deploys","is_archived":true},` +
+			`{"id":"C0000003","name":"deploys"},` +
+			`{"id":"C0000004","name":"shared"},` +
+			`{"id":"C0000005","name":"shared"}` +
+			`],"response_metadata":{"next_cursor":""}}`),
+		"users.list": respond(`{"ok":true,"members":[` +
+			`{"id":"U0000001","name":"alice","real_name":"Alice Smith"},` +
+			`{"id":"U0000002","name":"bob","real_name":"Sam Jones"},` +
+			`{"id":"U0000003","name":"sam","real_name":"Sam Jones","deleted":true},` +
+			`{"id":"U0000004","name":"sam.j","real_name":"Sam Jones"}` +
+			`],"response_metadata":{"next_cursor":""}}`),
+		"users.lookupByEmail": func(w http.ResponseWriter, r *http.Request) {
+			if r.FormValue("email") == "alice@example.com" {
+				_, _ = w.Write([]byte(`{"ok":true,"user":{"id":"U0000001","name":"alice"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"ok":false,"error":"users_not_found"}`))
+		},
+		"conversations.info": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"ok":true,"channel":{"id":%q,"name":"general"}}`, r.FormValue("channel"))
+		},
+		"conversations.invite": func(w http.ResponseWriter, r *http.Request) {
+			calls["invite "+r.FormValue("users")]++
+			fmt.Fprintf(w, `{"ok":true,"channel":{"id":%q}}`, r.FormValue("channel"))
+		},
+	}))
+}
+
+func TestNameResolver_ResolveChannel(t *testing.T) {
+	tests := []struct {
+		ref       string
+		want      string
+		wantCalls int
+	}{
+		{"C0000001", "C0000001", 0},
+		{"<#C0000001|general>", "C0000001", 0},
+		{"#general", "C0000001", 1},
+		{"General", "C0000001", 1},
+		{"#deploys", "C0000003", 1},
+		{"", "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			calls := map[string]int{}
+			r := NewNameResolver(newResolveTestClient(t, calls))
+
+			got, err := r.ResolveChannel(context.Background(), tt.ref)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantCalls, calls["conversations.list"])
+		})
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		r := NewNameResolver(newResolveTestClient(t, map[string]int{}))
+
+		_, err := r.ResolveChannel(context.Background(), "#random")
+
+		var se *SlackError
+		require.ErrorAs(t, err, &se)
+		assert.Equal(t, ErrNotFound, se.Code)
+		assert.Contains(t, se.Detail, `"#random"`)
+	})
+
+	t.Run("ambiguous", func(t *testing.T) {
+		r := NewNameResolver(newResolveTestClient(t, map[string]int{}))
+
+		_, err := r.ResolveChannel(context.Background(), "shared")
+
+		var se *SlackError
+		require.ErrorAs(t, err, &se)
+		assert.Equal(t, ErrValidation, se.Code)
+		assert.Equal(t, "ambiguous_name", se.Message)
+		assert.Contains(t, se.Detail, "C0000004 (#shared)")
+		assert.Contains(t, se.Detail, "C0000005 (#shared)")
+	})
+}
+
+func TestNameResolver_ResolveUsers(t *testing.T) {
+	t.Run("mixed references list users once", func(t *testing.T) {
+		calls := map[string]int{}
+		r := NewNameResolver(newResolveTestClient(t, calls))
+
+		got, err := r.ResolveUsers(context.Background(), []string{
+			"U0000009", "<@U0000002>", "@alice", "bob", "alice smith", "alice@example.com",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"U0000009", "U0000002", "U0000001", "U0000002", "U0000001", "U0000001"}, got)
+		assert.Equal(t, map[string]int{"users.list": 1, "users.lookupByEmail": 1}, calls)
+	})
+
+	t.Run("ambiguous real name skips deactivated", func(t *testing.T) {
+		r := NewNameResolver(newResolveTestClient(t, map[string]int{}))
+
+		_, err := r.ResolveUser(context.Background(), "Sam Jones")
+
+		var se *SlackError
+		require.ErrorAs(t, err, &se)
+		assert.Equal(t, "ambiguous_name", se.Message)
+		assert.Contains(t, se.Detail, "U0000002 (@bob, Sam Jones)")
+		assert.Contains(t, se.Detail, "U0000004 (@sam.j, Sam Jones)")
+		assert.NotContains(t, se.Detail, "U0000003")
+	})
+
+	t.Run("handle wins over real name", func(t *testing.T) {
+		r := NewNameResolver(newResolveTestClient(t, map[string]int{}))
+
+		got, err := r.ResolveUser(context.Background(), "@sam")
+
+		require.NoError(t, err)
+		assert.Equal(t, "U0000003", got)
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		r := NewNameResolver(newResolveTestClient(t, map[string]int{}))
+
+		_, err := r.ResolveUser(context.Background(), "nobody@example.com")
+
+		var se *SlackError
+		require.ErrorAs(t, err, &se)
+		assert.Equal(t, ErrNotFound, se.Code)
+	})
+}
+
+func TestWithNameResolution(t *testing.T) {
+	calls := map[string]int{}
+	svc := WithNameResolution(newResolveTestClient(t, calls))
+
+	ch, err := svc.GetChannelInfo(context.Background(), "#general")
+	require.NoError(t, err)
+	assert.Equal(t, "C0000001", ch.ID)
+
+	for _, err := range svc.IterMessages(context.Background(), ListMessagesParams{ChannelID: "#random"}) {
+		var se *SlackError
+		require.ErrorAs(t, err, &se)
+		assert.Equal(t, ErrNotFound, se.Code)
+	}
+
+	// @alice and her ID are the same user, invited once.
+	require.NoError(t, svc.InviteToChannel(context.Background(), "#general", "@alice", "U0000001", "bob"))
+	assert.Equal(t, 1, calls["invite U0000001,U0000002"])
+}
@@ -0,0 +1,133 @@
+package slack
+
+import (
+	"context"
+	"iter"
+	"strconv"
+	"time"
+
+	slackapi "github.com/slack-go/slack"
+)
+
+type ScheduledMessage struct {
+	ID          string `json:"id"`
+	Channel     string `json:"channel"`
+	Text        string `json:"text,omitempty"`
+	PostAt      int64  `json:"post_at"`
+	DateCreated int64  `json:"date_created,omitempty"`
+}
+
+func scheduledMessageFromAPI(m slackapi.ScheduledMessage) ScheduledMessage {
+	return ScheduledMessage{
+		ID:          m.ID,
+		Channel:     m.Channel,
+		Text:        m.Text,
+		PostAt:      int64(m.PostAt),
+		DateCreated: int64(m.DateCreated),
+	}
+}
+
+type ScheduleMessageParams struct {
+	ChannelID string
+	Text      string
+	ThreadTS  string
+	PostAt    time.Time
+}
+
+// ScheduleMessage queues a message to be posted at params.PostAt, which
+// Slack requires to be in the future and at most 120 days away.
+func (c *Client) ScheduleMessage(ctx context.Context, params ScheduleMessageParams) (*ScheduledMessage, error) {
+	opts := []slackapi.MsgOption{
+		slackapi.MsgOptionText(params.Text, false),
+	}
+	if params.ThreadTS != "" {
+		opts = append(opts, slackapi.MsgOptionTS(params.ThreadTS))
+	}
+	postAt := params.PostAt.Unix()
+
+	type result struct {
+		channel string
+		id      string
+	}
+
+	r, err := retry(ctx, c.writeEndpoint("chat.scheduleMessage"), func() (result, error) {
+		ch, id, err := c.api.ScheduleMessageContext(ctx, params.ChannelID, strconv.FormatInt(postAt, 10), opts...)
+		return result{ch, id}, err
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	return &ScheduledMessage{
+		ID:      r.id,
+		Channel: r.channel,
+		Text:    params.Text,
+		PostAt:  postAt,
+	}, nil
+}
+
+type ListScheduledMessagesParams struct {
+	ChannelID  string
+	Pagination PaginationParams
+}
+
+// ListScheduledMessages returns messages that are scheduled but not yet
+// posted, optionally restricted to one channel.
+func (c *Client) ListScheduledMessages(ctx context.Context, params ListScheduledMessagesParams) (*PaginatedResult[ScheduledMessage], error) {
+	if params.Pagination.All {
+		return collect(c.IterScheduledMessages(ctx, params))
+	}
+	return c.listScheduledMessagesPage(ctx, params)
+}
+
+func (c *Client) listScheduledMessagesPage(ctx context.Context, params ListScheduledMessagesParams) (*PaginatedResult[ScheduledMessage], error) {
+	type result struct {
+		messages []slackapi.ScheduledMessage
+		cursor   string
+	}
+
+	r, err := retry(ctx, c.endpoint("chat.scheduledMessages.list"), func() (result, error) {
+		msgs, cursor, err := c.api.GetScheduledMessagesContext(ctx, &slackapi.GetScheduledMessagesParameters{
+			Channel: params.ChannelID,
+			Cursor:  params.Pagination.Cursor,
+			Limit:   params.Pagination.EffectiveLimit(),
+		})
+		return result{messages: msgs, cursor: cursor}, err
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+
+	items := make([]ScheduledMessage, len(r.messages))
+	for i, m := range r.messages {
+		items[i] = scheduledMessageFromAPI(m)
+	}
+	return &PaginatedResult[ScheduledMessage]{
+		Items:      items,
+		NextCursor: r.cursor,
+		HasMore:    r.cursor != "",
+	}, nil
+}
+
+// IterScheduledMessages yields scheduled messages page by page as they are
+// fetched, starting at params.Pagination.Cursor.
+func (c *Client) IterScheduledMessages(ctx context.Context, params ListScheduledMessagesParams) iter.Seq2[ScheduledMessage, error] {
+	return paginate(params.Pagination.Cursor, func(cursor string) (*PaginatedResult[ScheduledMessage], error) {
+		p := params
+		p.Pagination = PaginationParams{Cursor: cursor, Limit: params.Pagination.EffectiveLimit()}
+		return c.listScheduledMessagesPage(ctx, p)
+	})
+}
+
+// DeleteScheduledMessage cancels a scheduled message before it is posted.
+func (c *Client) DeleteScheduledMessage(ctx context.Context, channelID, scheduledMessageID string) error {
+	_, err := retry(ctx, c.writeEndpoint("chat.deleteScheduledMessage"), func() (bool, error) {
+		return c.api.DeleteScheduledMessageContext(ctx, &slackapi.DeleteScheduledMessageParameters{
+			Channel:            channelID,
+			ScheduledMessageID: scheduledMessageID,
+		})
+	})
+	if err != nil {
+		return classifyError(err)
+	}
+	return nil
+}
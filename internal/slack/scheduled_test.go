@@ -0,0 +1,26 @@
+package slack
+
+import (
+	"testing"
+
+	slackapi "github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduledMessageFromAPI(t *testing.T) {
+	got := scheduledMessageFromAPI(slackapi.ScheduledMessage{
+		ID:          "Q123",
+		Channel:     "C123",
+		PostAt:      1700000000,
+		DateCreated: 1690000000,
+		Text:        "release notes",
+	})
+
+	assert.Equal(t, ScheduledMessage{
+		ID:          "Q123",
+		Channel:     "C123",
+		Text:        "release notes",
+		PostAt:      1700000000,
+		DateCreated: 1690000000,
+	}, got)
+}
@@ -1,9 +1,100 @@
 package slack
 
-// Search is handled by SearchMessages in messages.go
-// This file provides additional search-specific types if needed.
+import (
+	"context"
+
+	slackapi "github.com/slack-go/slack"
+)
+
+// SearchMessages lives in messages.go; file and combined search share its
+// SearchParams.
 
 type SearchFilesResult struct {
 	Matches []File `json:"matches"`
 	Total   int    `json:"total"`
+	SearchPaging
+}
+
+// SearchAllResult holds the message and file matches of one search.all call.
+// The limit and page in SearchParams apply to each list separately.
+type SearchAllResult struct {
+	Messages SearchResult      `json:"messages"`
+	Files    SearchFilesResult `json:"files"`
+}
+
+// SearchFiles returns one page of file matches, or with Pagination.All
+// every page up to params.MaxResults, as SearchMessages does.
+func (c *Client) SearchFiles(ctx context.Context, params SearchParams) (*SearchFilesResult, error) {
+	if !params.Pagination.All {
+		return c.searchFilesPage(ctx, params)
+	}
+	var result SearchFilesResult
+	err := collectSearch(params, &result.Matches, &result.Total, &result.SearchPaging, func(p SearchParams) ([]File, int, SearchPaging, error) {
+		page, err := c.searchFilesPage(ctx, p)
+		if err != nil {
+			return nil, 0, SearchPaging{}, err
+		}
+		return page.Matches, page.Total, page.SearchPaging, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) searchFilesPage(ctx context.Context, params SearchParams) (*SearchFilesResult, error) {
+	r, err := retry(ctx, c.endpoint("search.files"), func() (*slackapi.SearchFiles, error) {
+		return c.api.SearchFilesContext(ctx, params.Query, params.apiParams())
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	return searchFilesResultFromAPI(r), nil
+}
+
+// SearchAll returns one page of each kind of match from search.all. With
+// Pagination.All, messages and files run out of pages at different points,
+// so each is collected through its own search as SearchMessages and
+// SearchFiles do, up to params.MaxResults of each.
+func (c *Client) SearchAll(ctx context.Context, params SearchParams) (*SearchAllResult, error) {
+	if params.Pagination.All {
+		msgs, err := c.SearchMessages(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		files, err := c.SearchFiles(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return &SearchAllResult{Messages: *msgs, Files: *files}, nil
+	}
+
+	type result struct {
+		messages *slackapi.SearchMessages
+		files    *slackapi.SearchFiles
+	}
+
+	r, err := retry(ctx, c.endpoint("search.all"), func() (result, error) {
+		msgs, files, err := c.api.SearchContext(ctx, params.Query, params.apiParams())
+		return result{msgs, files}, err
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	return &SearchAllResult{
+		Messages: *searchResultFromAPI(r.messages),
+		Files:    *searchFilesResultFromAPI(r.files),
+	}, nil
+}
+
+func searchFilesResultFromAPI(r *slackapi.SearchFiles) *SearchFilesResult {
+	matches := make([]File, len(r.Matches))
+	for i, f := range r.Matches {
+		matches[i] = fileFromAPI(f)
+	}
+	return &SearchFilesResult{
+		Matches:      matches,
+		Total:        r.Total,
+		SearchPaging: searchPagingFromAPI(r.Paging),
+	}
 }
@@ -0,0 +1,182 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	slackapi "github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchResultFromAPI(t *testing.T) {
+	got := searchResultFromAPI(&slackapi.SearchMessages{
+		Matches: []slackapi.SearchMessage{{
+			Timestamp: "1700000000.000100",
+			User:      "U123",
+			Text:      "deploy done",
+			Channel:   slackapi.CtxChannel{ID: "C123", Name: "deploys"},
+			Permalink: "https://team.slack.com/archives/C123/p1700000000000100",
+		}},
+		Total: 42,
+	})
+
+	assert.Equal(t, &SearchResult{
+		Matches: []Message{{
+			Timestamp: "1700000000.000100",
+			User:      "U123",
+			Text:      "deploy done",
+			Channel:   "C123",
+			Type:      "message",
+			Permalink: "https://team.slack.com/archives/C123/p1700000000000100",
+		}},
+		Total: 42,
+	}, got)
+}
+
+func TestSearchFilesResultFromAPI(t *testing.T) {
+	got := searchFilesResultFromAPI(&slackapi.SearchFiles{
+		Matches: []slackapi.File{{ID: "F123", Name: "report.pdf"}},
+		Total:   7,
+	})
+
+	assert.Equal(t, 7, got.Total)
+	assert.Len(t, got.Matches, 1)
+	assert.Equal(t, "F123", got.Matches[0].ID)
+}
+
+func TestSearchParamsAPIParams(t *testing.T) {
+	got := SearchParams{
+		Query:      "deploy",
+		Sort:       "score",
+		SortDir:    "asc",
+		Pagination: PaginationParams{Limit: 30},
+	}.apiParams()
+
+	assert.Equal(t, slackapi.SearchParameters{Sort: "score", SortDirection: "asc", Count: 30, Page: 1}, got)
+}
+
+func TestSearchPagingFromAPI(t *testing.T) {
+	assert.Equal(t, SearchPaging{Page: 2, Pages: 3, PerPage: 20, HasMore: true},
+		searchPagingFromAPI(slackapi.Paging{Count: 20, Total: 55, Page: 2, Pages: 3}))
+	assert.Equal(t, SearchPaging{Page: 3, Pages: 3, PerPage: 20},
+		searchPagingFromAPI(slackapi.Paging{Count: 20, Total: 55, Page: 3, Pages: 3}))
+}
+
+// newSearchTestClient serves search.messages and search.files from pages of
+// matches, each page holding the given number of results.
+func newSearchTestClient(t *testing.T, pages []int, requested *[]string) *Client {
+	t.Helper()
+	serve := func(w http.ResponseWriter, r *http.Request) {
+		// slack-go omits page and count when they are Slack's defaults.
+		page, count := 1, 20
+		if v := r.FormValue("page"); v != "" {
+			page, _ = strconv.Atoi(v)
+		}
+		if v := r.FormValue("count"); v != "" {
+			count, _ = strconv.Atoi(v)
+		}
+		*requested = append(*requested, strconv.Itoa(page))
+		kind, match := "messages", `{"ts":"%d.%06d","text":"hit"}`
+		if r.URL.Path == "/search.files" {
+			kind, match = "files", `{"id":"F%d%06d","name":"hit.txt"}`
+		}
+		var matches []string
+		for i := range pages[page-1] {
+			matches = append(matches, fmt.Sprintf(match, page, i))
+		}
+		fmt.Fprintf(w, `{"ok":true,%q:{"matches":[%s],"total":%d,"paging":{"count":%d,"page":%d,"pages":%d}}}`,
+			kind, strings.Join(matches, ","), 55, count, page, len(pages))
+	}
+	return newTestClient(t, map[string]http.HandlerFunc{"search.messages": serve, "search.files": serve})
+}
+
+func TestSearchMessagesPagination(t *testing.T) {
+	t.Run("single page", func(t *testing.T) {
+		var requested []string
+		c := newSearchTestClient(t, []int{20, 20, 15}, &requested)
+
+		got, err := c.SearchMessages(context.Background(), SearchParams{
+			Query:      "hit",
+			Page:       2,
+			Pagination: PaginationParams{Limit: 20},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"2"}, requested)
+		assert.Len(t, got.Matches, 20)
+		assert.Equal(t, SearchPaging{Page: 2, Pages: 3, PerPage: 20, HasMore: true}, got.SearchPaging)
+	})
+
+	t.Run("all pages", func(t *testing.T) {
+		var requested []string
+		c := newSearchTestClient(t, []int{20, 20, 15}, &requested)
+
+		got, err := c.SearchMessages(context.Background(), SearchParams{
+			Query:      "hit",
+			Pagination: PaginationParams{Limit: 20, All: true},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1", "2", "3"}, requested)
+		assert.Len(t, got.Matches, 55)
+		assert.Equal(t, 3, got.Page)
+		assert.False(t, got.HasMore)
+	})
+
+	t.Run("all stops at cap", func(t *testing.T) {
+		var requested []string
+		c := newSearchTestClient(t, []int{20, 20, 15}, &requested)
+
+		got, err := c.SearchMessages(context.Background(), SearchParams{
+			Query:      "hit",
+			Pagination: PaginationParams{Limit: 20, All: true},
+			MaxResults: 30,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1", "2"}, requested)
+		assert.Len(t, got.Matches, 30)
+		assert.True(t, got.HasMore)
+	})
+}
+
+func TestSearchFilesPagination(t *testing.T) {
+	t.Run("all stops at cap", func(t *testing.T) {
+		var requested []string
+		c := newSearchTestClient(t, []int{20, 20, 15}, &requested)
+
+		got, err := c.SearchFiles(context.Background(), SearchParams{
+			Query:      "hit",
+			Pagination: PaginationParams{Limit: 20, All: true},
+			MaxResults: 30,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1", "2"}, requested)
+		assert.Len(t, got.Matches, 30)
+		assert.Equal(t, "F1000000", got.Matches[0].ID)
+		assert.True(t, got.HasMore)
+	})
+}
+
+func TestSearchAllPagination(t *testing.T) {
+	var requested []string
+	c := newSearchTestClient(t, []int{20, 20, 15}, &requested)
+
+	got, err := c.SearchAll(context.Background(), SearchParams{
+		Query:      "hit",
+		Pagination: PaginationParams{Limit: 20, All: true},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, got.Messages.Matches, 55)
+	assert.Len(t, got.Files.Matches, 55)
+	assert.False(t, got.Messages.HasMore)
+	assert.False(t, got.Files.HasMore)
+	assert.Len(t, requested, 6)
+}
@@ -1,40 +1,103 @@
 package slack
 
-import "io"
+import (
+	"context"
+	"io"
+	"iter"
+	"time"
+)
 
 //go:generate mockgen -source=service.go -destination=mocks/mock_service.go -package=mocks
 
 // Service defines the interface for all Slack API operations.
 // *Client satisfies this interface.
 type Service interface {
-	AuthTest() (*AuthTestResult, error)
-
-	ListChannels(params PaginationParams) (*PaginatedResult[Channel], error)
-	GetChannelInfo(channelID string) (*Channel, error)
-	CreateChannel(name string, isPrivate bool) (*Channel, error)
-	ArchiveChannel(channelID string) error
-	InviteToChannel(channelID string, userIDs ...string) error
-	KickFromChannel(channelID, userID string) error
-	SetChannelTopic(channelID, topic string) error
-	SetChannelPurpose(channelID, purpose string) error
-
-	ListMessages(params ListMessagesParams) (*PaginatedResult[Message], error)
-	SendMessage(params SendMessageParams) (*Message, error)
-	EditMessage(channelID, timestamp, text string) (*Message, error)
-	DeleteMessage(channelID, timestamp string) error
-	SearchMessages(params SearchParams) (*SearchResult, error)
-
-	ListUsers(params PaginationParams) (*PaginatedResult[User], error)
-	GetUserInfo(userID string) (*User, error)
-	GetUserPresence(userID string) (string, error)
-
-	AddReaction(channelID, timestamp, name string) error
-	RemoveReaction(channelID, timestamp, name string) error
-	ListReactions(userID string, params PaginationParams) (*PaginatedResult[ReactedItem], error)
-
-	ListFiles(params PaginationParams, channelID, userID string) (*PaginatedResult[File], error)
-	GetFileInfo(fileID string) (*File, error)
-	UploadFile(channelID, filename, title string, reader io.Reader) (*File, error)
-	DownloadFile(url, destPath string) error
-	DeleteFile(fileID string) error
+	AuthTest(ctx context.Context) (*AuthTestResult, error)
+
+	ListChannels(ctx context.Context, params ListChannelsParams) (*PaginatedResult[Channel], error)
+	IterChannels(ctx context.Context, params ListChannelsParams) iter.Seq2[Channel, error]
+	GetChannelInfo(ctx context.Context, channelID string) (*Channel, error)
+	ListChannelMembers(ctx context.Context, params ListChannelMembersParams) (*PaginatedResult[string], error)
+	IterChannelMembers(ctx context.Context, params ListChannelMembersParams) iter.Seq2[string, error]
+	CreateChannel(ctx context.Context, name string, isPrivate bool) (*Channel, error)
+	OpenConversation(ctx context.Context, userIDs ...string) (*Channel, error)
+	ArchiveChannel(ctx context.Context, channelID string) error
+	UnarchiveChannel(ctx context.Context, channelID string) error
+	JoinChannel(ctx context.Context, channelID string) (*Channel, error)
+	LeaveChannel(ctx context.Context, channelID string) error
+	RenameChannel(ctx context.Context, channelID, name string) (*Channel, error)
+	ConvertChannel(ctx context.Context, channelID string, private bool) error
+	InviteToChannel(ctx context.Context, channelID string, userIDs ...string) error
+	KickFromChannel(ctx context.Context, channelID, userID string) error
+	SetChannelTopic(ctx context.Context, channelID, topic string) error
+	SetChannelPurpose(ctx context.Context, channelID, purpose string) error
+
+	ListBookmarks(ctx context.Context, channelID string) ([]Bookmark, error)
+	AddBookmark(ctx context.Context, params AddBookmarkParams) (*Bookmark, error)
+	EditBookmark(ctx context.Context, params EditBookmarkParams) (*Bookmark, error)
+	RemoveBookmark(ctx context.Context, channelID, bookmarkID string) error
+
+	ListMessages(ctx context.Context, params ListMessagesParams) (*PaginatedResult[Message], error)
+	IterMessages(ctx context.Context, params ListMessagesParams) iter.Seq2[Message, error]
+	GetThread(ctx context.Context, params GetThreadParams) (*PaginatedResult[Message], error)
+	IterThread(ctx context.Context, params GetThreadParams) iter.Seq2[Message, error]
+	SendMessage(ctx context.Context, params SendMessageParams) (*Message, error)
+	PostEphemeral(ctx context.Context, params PostEphemeralParams) (*Message, error)
+	EditMessage(ctx context.Context, params EditMessageParams) (*Message, error)
+	DeleteMessage(ctx context.Context, channelID, timestamp string) error
+	SearchMessages(ctx context.Context, params SearchParams) (*SearchResult, error)
+	GetMessage(ctx context.Context, params GetMessageParams) (*Message, error)
+	GetMessageByPermalink(ctx context.Context, link string) (*Message, error)
+	GetPermalink(ctx context.Context, channelID, timestamp string) (string, error)
+	SearchFiles(ctx context.Context, params SearchParams) (*SearchFilesResult, error)
+	SearchAll(ctx context.Context, params SearchParams) (*SearchAllResult, error)
+
+	ScheduleMessage(ctx context.Context, params ScheduleMessageParams) (*ScheduledMessage, error)
+	ListScheduledMessages(ctx context.Context, params ListScheduledMessagesParams) (*PaginatedResult[ScheduledMessage], error)
+	IterScheduledMessages(ctx context.Context, params ListScheduledMessagesParams) iter.Seq2[ScheduledMessage, error]
+	DeleteScheduledMessage(ctx context.Context, channelID, scheduledMessageID string) error
+
+	ListUsers(ctx context.Context, params PaginationParams) (*PaginatedResult[User], error)
+	IterUsers(ctx context.Context, params PaginationParams) iter.Seq2[User, error]
+	GetUserInfo(ctx context.Context, userID string) (*User, error)
+	GetUsers(ctx context.Context, userIDs []string) ([]User, error)
+	LookupUserByEmail(ctx context.Context, email string) (*User, error)
+	GetUserPresence(ctx context.Context, userID string) (string, error)
+	SetStatus(ctx context.Context, params SetStatusParams) (*UserStatus, error)
+	SetPresence(ctx context.Context, presence string) error
+	GetDNDInfo(ctx context.Context, userID string) (*DNDStatus, error)
+	SetSnooze(ctx context.Context, d time.Duration) (*DNDStatus, error)
+	EndSnooze(ctx context.Context) (*DNDStatus, error)
+
+	ListUserGroups(ctx context.Context, params ListUserGroupsParams) ([]UserGroup, error)
+	ListUserGroupMembers(ctx context.Context, userGroupID string) ([]string, error)
+	CreateUserGroup(ctx context.Context, params CreateUserGroupParams) (*UserGroup, error)
+	UpdateUserGroup(ctx context.Context, params UpdateUserGroupParams) (*UserGroup, error)
+	SetUserGroupMembers(ctx context.Context, userGroupID string, userIDs []string) (*UserGroup, error)
+	DisableUserGroup(ctx context.Context, userGroupID string) (*UserGroup, error)
+	EnableUserGroup(ctx context.Context, userGroupID string) (*UserGroup, error)
+
+	ListEmoji(ctx context.Context) ([]Emoji, error)
+
+	AddReaction(ctx context.Context, channelID, timestamp, name string) error
+	RemoveReaction(ctx context.Context, channelID, timestamp, name string) error
+	ListReactions(ctx context.Context, userID string, params PaginationParams) (*PaginatedResult[ReactedItem], error)
+	IterReactions(ctx context.Context, userID string, params PaginationParams) iter.Seq2[ReactedItem, error]
+
+	AddPin(ctx context.Context, channelID, timestamp string) error
+	RemovePin(ctx context.Context, channelID, timestamp string) error
+	ListPins(ctx context.Context, channelID string) ([]PinnedItem, error)
+
+	AddReminder(ctx context.Context, params AddReminderParams) (*Reminder, error)
+	ListReminders(ctx context.Context) ([]Reminder, error)
+	GetReminderInfo(ctx context.Context, reminderID string) (*Reminder, error)
+	CompleteReminder(ctx context.Context, reminderID string) error
+	DeleteReminder(ctx context.Context, reminderID string) error
+
+	ListFiles(ctx context.Context, params PaginationParams, channelID, userID string) (*PaginatedResult[File], error)
+	IterFiles(ctx context.Context, params PaginationParams, channelID, userID string) iter.Seq2[File, error]
+	GetFileInfo(ctx context.Context, fileID string) (*File, error)
+	UploadFile(ctx context.Context, channelID, filename, title string, reader io.Reader) (*File, error)
+	DownloadFile(ctx context.Context, url, destPath string) error
+	DeleteFile(ctx context.Context, fileID string) error
 }
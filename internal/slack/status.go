@@ -0,0 +1,134 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	slackapi "github.com/slack-go/slack"
+)
+
+// UserStatus is the authenticated user's custom status. Expiration is a Unix
+// time, or 0 when the status does not expire.
+type UserStatus struct {
+	Text       string `json:"text"`
+	Emoji      string `json:"emoji"`
+	Expiration int64  `json:"expiration,omitempty"`
+}
+
+// SetStatusParams describes a custom status. Empty Text and Emoji clear the
+// status; a zero Expiration keeps it until it is changed.
+type SetStatusParams struct {
+	Text       string
+	Emoji      string
+	Expiration time.Time
+}
+
+// SetStatus sets the authenticated user's custom status. Emoji may be given
+// with or without colons and is checked like a reaction name.
+func (c *Client) SetStatus(ctx context.Context, params SetStatusParams) (*UserStatus, error) {
+	status := UserStatus{Text: params.Text}
+	if name := normalizeEmojiName(params.Emoji); name != "" {
+		if err := c.validateEmojiName(ctx, name); err != nil {
+			return nil, err
+		}
+		status.Emoji = ":" + name + ":"
+	}
+	if !params.Expiration.IsZero() {
+		status.Expiration = params.Expiration.Unix()
+	}
+
+	_, err := retry(ctx, c.writeEndpoint("users.profile.set"), func() (struct{}, error) {
+		return struct{}{}, c.api.SetUserCustomStatusContext(ctx, status.Text, status.Emoji, status.Expiration)
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	return &status, nil
+}
+
+// SetPresence sets the authenticated user's presence to "auto" or "away".
+func (c *Client) SetPresence(ctx context.Context, presence string) error {
+	if presence != "auto" && presence != "away" {
+		return &SlackError{
+			Code:    ErrValidation,
+			Message: "invalid_presence",
+			Detail:  fmt.Sprintf("presence must be \"auto\" or \"away\", not %q", presence),
+		}
+	}
+	_, err := retry(ctx, c.writeEndpoint("users.setPresence"), func() (struct{}, error) {
+		return struct{}{}, c.api.SetUserPresenceContext(ctx, presence)
+	})
+	if err != nil {
+		return classifyError(err)
+	}
+	return nil
+}
+
+// DNDStatus is a user's Do Not Disturb state. Times are Unix seconds.
+type DNDStatus struct {
+	Enabled         bool  `json:"dnd_enabled"`
+	NextStart       int64 `json:"next_dnd_start,omitempty"`
+	NextEnd         int64 `json:"next_dnd_end,omitempty"`
+	SnoozeEnabled   bool  `json:"snooze_enabled"`
+	SnoozeEnd       int64 `json:"snooze_end,omitempty"`
+	SnoozeRemaining int   `json:"snooze_remaining,omitempty"`
+}
+
+func dndStatusFromAPI(s *slackapi.DNDStatus) *DNDStatus {
+	return &DNDStatus{
+		Enabled:         s.Enabled,
+		NextStart:       int64(s.NextStartTimestamp),
+		NextEnd:         int64(s.NextEndTimestamp),
+		SnoozeEnabled:   s.SnoozeEnabled,
+		SnoozeEnd:       int64(s.SnoozeEndTime),
+		SnoozeRemaining: s.SnoozeRemaining,
+	}
+}
+
+// GetDNDInfo returns a user's Do Not Disturb state, or the authenticated
+// user's when userID is empty.
+func (c *Client) GetDNDInfo(ctx context.Context, userID string) (*DNDStatus, error) {
+	var user *string
+	if userID != "" {
+		user = &userID
+	}
+	s, err := retry(ctx, c.endpoint("dnd.info"), func() (*slackapi.DNDStatus, error) {
+		return c.api.GetDNDInfoContext(ctx, user)
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	return dndStatusFromAPI(s), nil
+}
+
+// SetSnooze turns on Do Not Disturb for the authenticated user for d,
+// rounded up to whole minutes.
+func (c *Client) SetSnooze(ctx context.Context, d time.Duration) (*DNDStatus, error) {
+	minutes := int((d + time.Minute - 1) / time.Minute)
+	if minutes < 1 {
+		return nil, &SlackError{
+			Code:    ErrValidation,
+			Message: "invalid_duration",
+			Detail:  fmt.Sprintf("snooze duration must be positive, got %s", d),
+		}
+	}
+	s, err := retry(ctx, c.writeEndpoint("dnd.setSnooze"), func() (*slackapi.DNDStatus, error) {
+		return c.api.SetSnoozeContext(ctx, minutes)
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	return dndStatusFromAPI(s), nil
+}
+
+// EndSnooze ends the authenticated user's Do Not Disturb snooze early.
+func (c *Client) EndSnooze(ctx context.Context) (*DNDStatus, error) {
+	s, err := retry(ctx, c.writeEndpoint("dnd.endSnooze"), func() (*slackapi.DNDStatus, error) {
+		return c.api.EndSnoozeContext(ctx)
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	return dndStatusFromAPI(s), nil
+}
@@ -0,0 +1,71 @@
+package slack
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	slackapi "github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDNDStatusFromAPI(t *testing.T) {
+	got := dndStatusFromAPI(&slackapi.DNDStatus{
+		Enabled:            true,
+		NextStartTimestamp: 1700000000,
+		NextEndTimestamp:   1700030000,
+		SnoozeInfo: slackapi.SnoozeInfo{
+			SnoozeEnabled:   true,
+			SnoozeEndTime:   1700001800,
+			SnoozeRemaining: 1800,
+		},
+	})
+
+	assert.Equal(t, &DNDStatus{
+		Enabled:         true,
+		NextStart:       1700000000,
+		NextEnd:         1700030000,
+		SnoozeEnabled:   true,
+		SnoozeEnd:       1700001800,
+		SnoozeRemaining: 1800,
+	}, got)
+}
+
+func TestSetPresenceValidation(t *testing.T) {
+	c := &Client{}
+
+	err := c.SetPresence(context.Background(), "busy")
+
+	var se *SlackError
+	require.ErrorAs(t, err, &se)
+	assert.Equal(t, ErrValidation, se.Code)
+	assert.Equal(t, "invalid_presence", se.Message)
+}
+
+func TestSetSnoozeValidation(t *testing.T) {
+	c := &Client{}
+
+	_, err := c.SetSnooze(context.Background(), 0)
+
+	var se *SlackError
+	require.ErrorAs(t, err, &se)
+	assert.Equal(t, ErrValidation, se.Code)
+	assert.Equal(t, "invalid_duration", se.Message)
+}
+
+func TestSetStatusRejectsUnknownEmoji(t *testing.T) {
+	c := &Client{}
+
+	// "skin-tone-9" is not a standard modifier, so no API call is needed
+	// to reject it.
+	_, err := c.SetStatus(context.Background(), SetStatusParams{
+		Text:       "deploying",
+		Emoji:      ":rocket::skin-tone-9:",
+		Expiration: time.Now().Add(time.Hour),
+	})
+
+	var se *SlackError
+	require.ErrorAs(t, err, &se)
+	assert.Equal(t, "invalid_name", se.Message)
+}
@@ -0,0 +1,82 @@
+package slack
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	slackapi "github.com/slack-go/slack"
+)
+
+type GetThreadParams struct {
+	ChannelID  string
+	ThreadTS   string
+	Pagination PaginationParams
+	Oldest     time.Time
+	Latest     time.Time
+}
+
+// GetThread returns a thread's parent message followed by its replies in
+// chronological order.
+func (c *Client) GetThread(ctx context.Context, params GetThreadParams) (*PaginatedResult[Message], error) {
+	if params.Pagination.All {
+		return collect(c.IterThread(ctx, params))
+	}
+	return c.getThreadPage(ctx, params)
+}
+
+func (c *Client) getThreadPage(ctx context.Context, params GetThreadParams) (*PaginatedResult[Message], error) {
+	type result struct {
+		messages []slackapi.Message
+		hasMore  bool
+		cursor   string
+	}
+
+	repliesParams := &slackapi.GetConversationRepliesParameters{
+		ChannelID: params.ChannelID,
+		Timestamp: params.ThreadTS,
+		Cursor:    params.Pagination.Cursor,
+		Limit:     params.Pagination.EffectiveLimit(),
+	}
+	if !params.Oldest.IsZero() {
+		repliesParams.Oldest = formatTimestamp(params.Oldest)
+	}
+	if !params.Latest.IsZero() {
+		repliesParams.Latest = formatTimestamp(params.Latest)
+	}
+
+	r, err := retry(ctx, c.endpoint("conversations.replies"), func() (result, error) {
+		msgs, hasMore, cursor, err := c.api.GetConversationRepliesContext(ctx, repliesParams)
+		return result{messages: msgs, hasMore: hasMore, cursor: cursor}, err
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+
+	// Slack repeats the parent at the top of every page; only the first
+	// page keeps it.
+	items := make([]Message, 0, len(r.messages))
+	for _, msg := range r.messages {
+		if params.Pagination.Cursor != "" && msg.Timestamp == params.ThreadTS {
+			continue
+		}
+		m := messageFromAPI(msg)
+		m.Channel = params.ChannelID
+		items = append(items, m)
+	}
+	return &PaginatedResult[Message]{
+		Items:      items,
+		NextCursor: r.cursor,
+		HasMore:    r.hasMore,
+	}, nil
+}
+
+// IterThread yields a thread's messages page by page as they are fetched,
+// starting at params.Pagination.Cursor.
+func (c *Client) IterThread(ctx context.Context, params GetThreadParams) iter.Seq2[Message, error] {
+	return paginate(params.Pagination.Cursor, func(cursor string) (*PaginatedResult[Message], error) {
+		p := params
+		p.Pagination = PaginationParams{Cursor: cursor, Limit: params.Pagination.EffectiveLimit()}
+		return c.getThreadPage(ctx, p)
+	})
+}
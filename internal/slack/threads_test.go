@@ -0,0 +1,87 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newThreadTestClient serves a thread of a parent and three replies over two
+// pages of conversations.replies, each starting with the parent as Slack's
+// do.
+func newThreadTestClient(t *testing.T) *Client {
+	return newTestClient(t, map[string]http.HandlerFunc{
+		"conversations.replies": func(w http.ResponseWriter, r *http.Request) {
+			if r.FormValue("cursor") == "" {
+				_, _ = w.Write([]byte(`{"ok":true,"messages":[` +
+					`{"ts":"1700000000.000100","thread_ts":"1700000000.000100","text":"parent","reply_count":3},` +
+					`{"ts":"1700000001.000100","thread_ts":"1700000000.000100","text":"one"},` +
+					`{"ts":"1700000002.000100","thread_ts":"1700000000.000100","text":"two"}` +
+					`],"has_more":true,"response_metadata":{"next_cursor":"page2"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"ok":true,"messages":[` +
+				`{"ts":"1700000000.000100","thread_ts":"1700000000.000100","text":"parent","reply_count":3},` +
+				`{"ts":"1700000003.000100","thread_ts":"1700000000.000100","text":"three"}` +
+				`],"has_more":false,"response_metadata":{"next_cursor":""}}`))
+		},
+	})
+}
+
+func TestGetThread(t *testing.T) {
+	params := GetThreadParams{ChannelID: "C123", ThreadTS: "1700000000.000100"}
+	texts := func(msgs []Message) []string {
+		var out []string
+		for _, m := range msgs {
+			out = append(out, m.Text)
+		}
+		return out
+	}
+
+	t.Run("all pages yield the parent once", func(t *testing.T) {
+		p := params
+		p.Pagination = PaginationParams{Limit: 3, All: true}
+
+		got, err := newThreadTestClient(t).GetThread(context.Background(), p)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"parent", "one", "two", "three"}, texts(got.Items))
+		assert.Equal(t, "C123", got.Items[0].Channel)
+	})
+
+	t.Run("a later page skips the parent", func(t *testing.T) {
+		p := params
+		p.Pagination = PaginationParams{Limit: 3, Cursor: "page2"}
+
+		got, err := newThreadTestClient(t).GetThread(context.Background(), p)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"three"}, texts(got.Items))
+		assert.False(t, got.HasMore)
+	})
+
+	t.Run("bounds reach conversations.replies", func(t *testing.T) {
+		var form url.Values
+		c := newTestClient(t, map[string]http.HandlerFunc{
+			"conversations.replies": func(w http.ResponseWriter, r *http.Request) {
+				_ = r.ParseForm()
+				form = r.Form
+				_, _ = w.Write([]byte(`{"ok":true,"messages":[]}`))
+			},
+		})
+		p := params
+		p.Oldest = time.Unix(1700000001, 100000)
+		p.Latest = time.Unix(1700000500, 0)
+
+		_, err := c.GetThread(context.Background(), p)
+
+		require.NoError(t, err)
+		assert.Equal(t, "1700000001.000100", form.Get("oldest"))
+		assert.Equal(t, "1700000500.000000", form.Get("latest"))
+	})
+}
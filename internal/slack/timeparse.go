@@ -0,0 +1,74 @@
+package slack
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseTime parses s as an absolute RFC 3339 time or as a time relative to
+// now: a Go duration such as "90m" or "1h30m", optionally prefixed with "+"
+// or "in ", or a whole number of days such as "2d".
+func ParseTime(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+
+	rel := strings.TrimPrefix(strings.TrimPrefix(s, "in "), "+")
+	d, err := parseRelative(strings.TrimSpace(rel))
+	if err != nil {
+		return time.Time{}, &SlackError{
+			Code:    ErrValidation,
+			Message: "invalid_time",
+			Detail:  fmt.Sprintf("%q is not an RFC 3339 time or a relative duration like 30m, 2h or 1d", s),
+		}
+	}
+	if d <= 0 {
+		return time.Time{}, &SlackError{
+			Code:    ErrValidation,
+			Message: "invalid_time",
+			Detail:  fmt.Sprintf("relative time %q must be in the future", s),
+		}
+	}
+	return now.Add(d), nil
+}
+
+func parseRelative(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
+
+// ParseTimeBound parses s as the bound of a time range: an RFC 3339 time, a
+// Slack message timestamp such as "1700000000.000100", or a duration before
+// now such as "90m" or "7d".
+func ParseTimeBound(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if sec, frac, ok := strings.Cut(s, "."); ok {
+		secs, err1 := strconv.ParseInt(sec, 10, 64)
+		micros, err2 := strconv.ParseInt(frac, 10, 64)
+		if err1 == nil && err2 == nil {
+			return time.Unix(secs, micros*int64(time.Microsecond)), nil
+		}
+	}
+
+	d, err := parseRelative(strings.TrimPrefix(s, "-"))
+	if err != nil || d <= 0 {
+		return time.Time{}, &SlackError{
+			Code:    ErrValidation,
+			Message: "invalid_time",
+			Detail:  fmt.Sprintf("%q is not an RFC 3339 time, a message timestamp or a duration like 30m, 2h or 1d", s),
+		}
+	}
+	return now.Add(-d), nil
+}
@@ -0,0 +1,81 @@
+package slack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input string
+		want  time.Time
+	}{
+		{"rfc3339", "2024-01-16T10:30:00Z", time.Date(2024, 1, 16, 10, 30, 0, 0, time.UTC)},
+		{"duration", "90m", now.Add(90 * time.Minute)},
+		{"plus prefix", "+2h", now.Add(2 * time.Hour)},
+		{"in prefix", "in 1h30m", now.Add(90 * time.Minute)},
+		{"days", "2d", now.Add(48 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTime(tt.input, now)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %s, want %s", got, tt.want)
+		})
+	}
+}
+
+func TestParseTime_Invalid(t *testing.T) {
+	now := time.Now()
+
+	for _, input := range []string{"", "tomorrow", "-1h", "0s", "xd"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseTime(input, now)
+
+			var se *SlackError
+			require.ErrorAs(t, err, &se)
+			assert.Equal(t, ErrValidation, se.Code)
+		})
+	}
+}
+
+func TestParseTimeBound(t *testing.T) {
+	now := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input string
+		want  time.Time
+	}{
+		{"rfc3339", "2024-01-14T10:30:00Z", time.Date(2024, 1, 14, 10, 30, 0, 0, time.UTC)},
+		{"message timestamp", "1700000000.000100", time.Unix(1700000000, 100000)},
+		{"duration ago", "90m", now.Add(-90 * time.Minute)},
+		{"minus prefix", "-2h", now.Add(-2 * time.Hour)},
+		{"days ago", "7d", now.Add(-7 * 24 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTimeBound(tt.input, now)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %s, want %s", got, tt.want)
+		})
+	}
+
+	for _, input := range []string{"", "yesterday", "0s", "1.x"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := ParseTimeBound(input, now)
+
+			var se *SlackError
+			require.ErrorAs(t, err, &se)
+			assert.Equal(t, ErrValidation, se.Code)
+		})
+	}
+}
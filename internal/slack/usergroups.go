@@ -0,0 +1,162 @@
+package slack
+
+import (
+	"context"
+
+	slackapi "github.com/slack-go/slack"
+)
+
+type UserGroup struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Handle      string   `json:"handle"`
+	Description string   `json:"description,omitempty"`
+	UserCount   int      `json:"user_count"`
+	IsDisabled  bool     `json:"is_disabled"`
+	Channels    []string `json:"channels,omitempty"`
+	Users       []string `json:"users,omitempty"`
+	Created     int64    `json:"created"`
+}
+
+func userGroupFromAPI(g slackapi.UserGroup) UserGroup {
+	return UserGroup{
+		ID:          g.ID,
+		Name:        g.Name,
+		Handle:      g.Handle,
+		Description: g.Description,
+		UserCount:   g.UserCount,
+		IsDisabled:  g.DateDelete != 0,
+		Channels:    g.Prefs.Channels,
+		Users:       g.Users,
+		Created:     int64(g.DateCreate),
+	}
+}
+
+type ListUserGroupsParams struct {
+	IncludeDisabled bool
+	IncludeUsers    bool
+}
+
+// ListUserGroups returns the workspace's user groups. usergroups.list is
+// not paginated.
+func (c *Client) ListUserGroups(ctx context.Context, params ListUserGroupsParams) ([]UserGroup, error) {
+	groups, err := retry(ctx, c.endpoint("usergroups.list"), func() ([]slackapi.UserGroup, error) {
+		return c.api.GetUserGroupsContext(ctx,
+			slackapi.GetUserGroupsOptionIncludeCount(true),
+			slackapi.GetUserGroupsOptionIncludeDisabled(params.IncludeDisabled),
+			slackapi.GetUserGroupsOptionIncludeUsers(params.IncludeUsers),
+		)
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+
+	result := make([]UserGroup, len(groups))
+	for i, g := range groups {
+		result[i] = userGroupFromAPI(g)
+	}
+	return result, nil
+}
+
+// ListUserGroupMembers returns the IDs of a user group's members.
+func (c *Client) ListUserGroupMembers(ctx context.Context, userGroupID string) ([]string, error) {
+	members, err := retry(ctx, c.endpoint("usergroups.users.list"), func() ([]string, error) {
+		return c.api.GetUserGroupMembersContext(ctx, userGroupID)
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	return members, nil
+}
+
+type CreateUserGroupParams struct {
+	Name        string
+	Handle      string
+	Description string
+	Channels    []string // default channels for new members
+}
+
+func (c *Client) CreateUserGroup(ctx context.Context, params CreateUserGroupParams) (*UserGroup, error) {
+	g, err := retry(ctx, c.writeEndpoint("usergroups.create"), func() (slackapi.UserGroup, error) {
+		return c.api.CreateUserGroupContext(ctx, slackapi.UserGroup{
+			Name:        params.Name,
+			Handle:      params.Handle,
+			Description: params.Description,
+			Prefs:       slackapi.UserGroupPrefs{Channels: params.Channels},
+		}, slackapi.CreateUserGroupOptionIncludeCount(true))
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	group := userGroupFromAPI(g)
+	return &group, nil
+}
+
+// UpdateUserGroupParams changes a user group. Empty Name and Handle, nil
+// Description and nil Channels leave the current value.
+type UpdateUserGroupParams struct {
+	UserGroupID string
+	Name        string
+	Handle      string
+	Description *string
+	Channels    []string
+}
+
+func (c *Client) UpdateUserGroup(ctx context.Context, params UpdateUserGroupParams) (*UserGroup, error) {
+	var opts []slackapi.UpdateUserGroupsOption
+	if params.Name != "" {
+		opts = append(opts, slackapi.UpdateUserGroupsOptionName(params.Name))
+	}
+	if params.Handle != "" {
+		opts = append(opts, slackapi.UpdateUserGroupsOptionHandle(params.Handle))
+	}
+	if params.Description != nil {
+		opts = append(opts, slackapi.UpdateUserGroupsOptionDescription(params.Description))
+	}
+	if params.Channels != nil {
+		opts = append(opts, slackapi.UpdateUserGroupsOptionChannels(params.Channels))
+	}
+
+	g, err := retry(ctx, c.writeEndpoint("usergroups.update"), func() (slackapi.UserGroup, error) {
+		return c.api.UpdateUserGroupContext(ctx, params.UserGroupID, opts...)
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	group := userGroupFromAPI(g)
+	return &group, nil
+}
+
+// SetUserGroupMembers replaces a user group's members with userIDs.
+func (c *Client) SetUserGroupMembers(ctx context.Context, userGroupID string, userIDs []string) (*UserGroup, error) {
+	g, err := retry(ctx, c.writeEndpoint("usergroups.users.update"), func() (slackapi.UserGroup, error) {
+		return c.api.UpdateUserGroupMembersListContext(ctx, userGroupID, userIDs)
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	group := userGroupFromAPI(g)
+	return &group, nil
+}
+
+func (c *Client) DisableUserGroup(ctx context.Context, userGroupID string) (*UserGroup, error) {
+	g, err := retry(ctx, c.writeEndpoint("usergroups.disable"), func() (slackapi.UserGroup, error) {
+		return c.api.DisableUserGroupContext(ctx, userGroupID)
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	group := userGroupFromAPI(g)
+	return &group, nil
+}
+
+func (c *Client) EnableUserGroup(ctx context.Context, userGroupID string) (*UserGroup, error) {
+	g, err := retry(ctx, c.writeEndpoint("usergroups.enable"), func() (slackapi.UserGroup, error) {
+		return c.api.EnableUserGroupContext(ctx, userGroupID)
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	group := userGroupFromAPI(g)
+	return &group, nil
+}
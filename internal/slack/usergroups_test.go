@@ -0,0 +1,40 @@
+package slack
+
+import (
+	"testing"
+
+	slackapi "github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserGroupFromAPI(t *testing.T) {
+	t.Run("active", func(t *testing.T) {
+		got := userGroupFromAPI(slackapi.UserGroup{
+			ID:          "S123",
+			Name:        "Payments on-call",
+			Handle:      "oncall-payments",
+			Description: "Whoever holds the pager",
+			UserCount:   2,
+			DateCreate:  1700000000,
+			Prefs:       slackapi.UserGroupPrefs{Channels: []string{"C123"}},
+			Users:       []string{"U1", "U2"},
+		})
+
+		assert.Equal(t, UserGroup{
+			ID:          "S123",
+			Name:        "Payments on-call",
+			Handle:      "oncall-payments",
+			Description: "Whoever holds the pager",
+			UserCount:   2,
+			Channels:    []string{"C123"},
+			Users:       []string{"U1", "U2"},
+			Created:     1700000000,
+		}, got)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		got := userGroupFromAPI(slackapi.UserGroup{ID: "S123", DateDelete: 1700000500})
+
+		assert.True(t, got.IsDisabled)
+	})
+}
@@ -2,70 +2,84 @@ package slack
 
 import (
 	"context"
+	"errors"
+	"iter"
+	"slices"
+	"strings"
 
 	slackapi "github.com/slack-go/slack"
 )
 
 type User struct {
-	ID       string `json:"id"`
-	Name     string `json:"name"`
-	RealName string `json:"real_name"`
-	Email    string `json:"email,omitempty"`
-	IsAdmin  bool   `json:"is_admin"`
-	IsBot    bool   `json:"is_bot"`
-	Deleted  bool   `json:"deleted"`
-	TZ       string `json:"tz,omitempty"`
-	Presence string `json:"presence,omitempty"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	RealName    string `json:"real_name"`
+	DisplayName string `json:"display_name,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Email       string `json:"email,omitempty"`
+	IsAdmin     bool   `json:"is_admin"`
+	IsBot       bool   `json:"is_bot"`
+	Deleted     bool   `json:"deleted"`
+	TZ          string `json:"tz,omitempty"`
+	Presence    string `json:"presence,omitempty"`
 }
 
 func userFromAPI(u slackapi.User) User {
 	return User{
-		ID:       u.ID,
-		Name:     u.Name,
-		RealName: u.RealName,
-		Email:    u.Profile.Email,
-		IsAdmin:  u.IsAdmin,
-		IsBot:    u.IsBot,
-		Deleted:  u.Deleted,
-		TZ:       u.TZ,
+		ID:          u.ID,
+		Name:        u.Name,
+		RealName:    u.RealName,
+		DisplayName: u.Profile.DisplayName,
+		Title:       u.Profile.Title,
+		Email:       u.Profile.Email,
+		IsAdmin:     u.IsAdmin,
+		IsBot:       u.IsBot,
+		Deleted:     u.Deleted,
+		TZ:          u.TZ,
 	}
 }
 
-func (c *Client) ListUsers(params PaginationParams) (*PaginatedResult[User], error) {
+func (c *Client) ListUsers(ctx context.Context, params PaginationParams) (*PaginatedResult[User], error) {
+	if params.All {
+		return collect(c.IterUsers(ctx, params))
+	}
+	return c.listUsersPage(ctx, params)
+}
+
+func (c *Client) listUsersPage(ctx context.Context, params PaginationParams) (*PaginatedResult[User], error) {
 	// slack-go uses GetUsersPaginated for paginated user lists
-	var allUsers []User
 	pager := c.api.GetUsersPaginated(slackapi.GetUsersOptionLimit(params.EffectiveLimit()))
+	pager.Cursor = params.Cursor
 
-	for {
-		var err error
-		pager, err = pager.Next(context.Background())
-		if err != nil {
-			if pager.Done(err) {
-				break
-			}
-			return nil, classifyError(err)
-		}
-		for _, u := range pager.Users {
-			allUsers = append(allUsers, userFromAPI(u))
-		}
-		if !params.All {
-			// Return first page only
-			return &PaginatedResult[User]{
-				Items:   allUsers,
-				HasMore: !pager.Done(nil),
-			}, nil
-		}
+	next, err := retry(ctx, c.endpoint("users.list"), func() (slackapi.UserPagination, error) {
+		return pager.Next(ctx)
+	})
+	if err != nil {
+		return nil, classifyError(err)
 	}
 
+	items := make([]User, len(next.Users))
+	for i, u := range next.Users {
+		items[i] = userFromAPI(u)
+	}
 	return &PaginatedResult[User]{
-		Items:   allUsers,
-		HasMore: false,
+		Items:      items,
+		NextCursor: next.Cursor,
+		HasMore:    next.Cursor != "",
 	}, nil
 }
 
-func (c *Client) GetUserInfo(userID string) (*User, error) {
-	u, err := retry(func() (*slackapi.User, error) {
-		return c.api.GetUserInfo(userID)
+// IterUsers yields workspace members page by page as they are fetched,
+// starting at params.Cursor.
+func (c *Client) IterUsers(ctx context.Context, params PaginationParams) iter.Seq2[User, error] {
+	return paginate(params.Cursor, func(cursor string) (*PaginatedResult[User], error) {
+		return c.listUsersPage(ctx, PaginationParams{Cursor: cursor, Limit: params.EffectiveLimit()})
+	})
+}
+
+func (c *Client) GetUserInfo(ctx context.Context, userID string) (*User, error) {
+	u, err := retry(ctx, c.endpoint("users.info"), func() (*slackapi.User, error) {
+		return c.api.GetUserInfoContext(ctx, userID)
 	})
 	if err != nil {
 		return nil, classifyError(err)
@@ -74,12 +88,95 @@ func (c *Client) GetUserInfo(userID string) (*User, error) {
 	return &result, nil
 }
 
-func (c *Client) GetUserPresence(userID string) (string, error) {
-	p, err := retry(func() (*slackapi.UserPresence, error) {
-		return c.api.GetUserPresence(userID)
+// usersInfoBatchSize is how many IDs GetUsers asks users.info for in one
+// call.
+const usersInfoBatchSize = 30
+
+// GetUsers returns the users with the given IDs, in the same order. The IDs
+// are looked up with users.info in batches, so the cost follows the number
+// of IDs rather than the size of the workspace. A batch Slack rejects
+// because one of its IDs is unknown is retried one ID at a time so the error
+// names the culprit; any other failure, such as an auth error, a rate limit
+// or a cancelled context, is returned at once.
+//
+// When some IDs are unknown, GetUsers still returns the users it found, with
+// a zero User in place of each unknown one, alongside a not_found error
+// naming the unknown IDs.
+func (c *Client) GetUsers(ctx context.Context, userIDs []string) ([]User, error) {
+	found := make(map[string]User, len(userIDs))
+	var unique []string
+	seen := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	for batch := range slices.Chunk(unique, usersInfoBatchSize) {
+		if len(batch) == 1 {
+			break // a lone ID is looked up on its own below
+		}
+		us, err := retry(ctx, c.endpoint("users.info"), func() (*[]slackapi.User, error) {
+			return c.api.GetUsersInfoContext(ctx, batch...)
+		})
+		if err != nil {
+			if se := classifyError(err); se.Code != ErrNotFound {
+				return nil, se
+			}
+			continue
+		}
+		for _, u := range *us {
+			found[u.ID] = userFromAPI(u)
+		}
+	}
+
+	users := make([]User, len(userIDs))
+	var unknown []string
+	for i, id := range userIDs {
+		u, ok := found[id]
+		if !ok && !slices.Contains(unknown, id) {
+			info, err := c.GetUserInfo(ctx, id)
+			var se *SlackError
+			switch {
+			case errors.As(err, &se) && se.Code == ErrNotFound:
+				unknown = append(unknown, id)
+				continue
+			case err != nil:
+				return nil, err
+			}
+			u = *info
+			found[id] = u
+		}
+		users[i] = u
+	}
+	if len(unknown) > 0 {
+		return users, &SlackError{
+			Code:    ErrNotFound,
+			Message: "user_not_found",
+			Detail:  "unknown user IDs: " + strings.Join(unknown, ", "),
+		}
+	}
+	return users, nil
+}
+
+func (c *Client) GetUserPresence(ctx context.Context, userID string) (string, error) {
+	p, err := retry(ctx, c.endpoint("users.getPresence"), func() (*slackapi.UserPresence, error) {
+		return c.api.GetUserPresenceContext(ctx, userID)
 	})
 	if err != nil {
 		return "", classifyError(err)
 	}
 	return p.Presence, nil
 }
+
+// LookupUserByEmail returns the user with the given email address.
+func (c *Client) LookupUserByEmail(ctx context.Context, email string) (*User, error) {
+	u, err := retry(ctx, c.endpoint("users.lookupByEmail"), func() (*slackapi.User, error) {
+		return c.api.GetUserByEmailContext(ctx, email)
+	})
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	user := userFromAPI(*u)
+	return &user, nil
+}
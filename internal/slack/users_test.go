@@ -1,10 +1,15 @@
 package slack
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
 	slackapi "github.com/slack-go/slack"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUserFromAPI(t *testing.T) {
@@ -13,7 +18,9 @@ func TestUserFromAPI(t *testing.T) {
 		Name:     "jdoe",
 		RealName: "Jane Doe",
 		Profile: slackapi.UserProfile{
-			Email: "jane@example.com",
+			Email:       "jane@example.com",
+			DisplayName: "jane",
+			Title:       "Staff Engineer",
 		},
 		IsAdmin: true,
 		IsBot:   false,
@@ -27,6 +34,8 @@ func TestUserFromAPI(t *testing.T) {
 	assert.Equal(t, "jdoe", got.Name)
 	assert.Equal(t, "Jane Doe", got.RealName)
 	assert.Equal(t, "jane@example.com", got.Email)
+	assert.Equal(t, "jane", got.DisplayName)
+	assert.Equal(t, "Staff Engineer", got.Title)
 	assert.True(t, got.IsAdmin)
 	assert.False(t, got.IsBot)
 	assert.False(t, got.Deleted)
@@ -47,3 +56,119 @@ func TestUserFromAPI_Empty(t *testing.T) {
 	assert.False(t, got.Deleted)
 	assert.Empty(t, got.TZ)
 }
+
+func TestGetUsers(t *testing.T) {
+	// newUsersTestClient serves users.list and users.info, for one ID or a
+	// comma-separated batch, counting calls per method. UBAD is unknown, and
+	// fails any batch it is part of.
+	newUsersTestClient := func(t *testing.T, calls map[string]int) *Client {
+		return newTestClient(t, counted(calls, map[string]http.HandlerFunc{
+			"users.list": respond(`{"ok":true,"members":[],"response_metadata":{"next_cursor":""}}`),
+			"users.info": func(w http.ResponseWriter, r *http.Request) {
+				ids := strings.Split(r.FormValue("users"), ",")
+				if r.FormValue("users") == "" {
+					ids = []string{r.FormValue("user")}
+				}
+				var users []string
+				for _, id := range ids {
+					if id == "UBAD" {
+						_, _ = w.Write([]byte(`{"ok":false,"error":"user_not_found"}`))
+						return
+					}
+					users = append(users, fmt.Sprintf(`{"id":%q,"name":"info-%s"}`, id, id))
+				}
+				if r.FormValue("users") == "" {
+					fmt.Fprintf(w, `{"ok":true,"user":%s}`, users[0])
+					return
+				}
+				fmt.Fprintf(w, `{"ok":true,"users":[%s]}`, strings.Join(users, ","))
+			},
+		}))
+	}
+	ids := func(n int) []string {
+		var ids []string
+		for i := range n {
+			ids = append(ids, fmt.Sprintf("U%d", i))
+		}
+		return ids
+	}
+
+	t.Run("few IDs take one batch", func(t *testing.T) {
+		calls := map[string]int{}
+		c := newUsersTestClient(t, calls)
+
+		got, err := c.GetUsers(context.Background(), []string{"U2", "U1", "U2"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"info-U2", "info-U1", "info-U2"}, []string{got[0].Name, got[1].Name, got[2].Name})
+		assert.Equal(t, map[string]int{"users.info": 1}, calls)
+	})
+
+	t.Run("many IDs never list the workspace", func(t *testing.T) {
+		calls := map[string]int{}
+		c := newUsersTestClient(t, calls)
+
+		got, err := c.GetUsers(context.Background(), ids(21))
+
+		require.NoError(t, err)
+		require.Len(t, got, 21)
+		assert.Equal(t, "info-U20", got[20].Name)
+		assert.Zero(t, calls["users.list"])
+		assert.Equal(t, 1, calls["users.info"])
+	})
+
+	t.Run("IDs are batched", func(t *testing.T) {
+		calls := map[string]int{}
+		c := newUsersTestClient(t, calls)
+
+		got, err := c.GetUsers(context.Background(), ids(2*usersInfoBatchSize+1))
+
+		require.NoError(t, err)
+		assert.Len(t, got, 2*usersInfoBatchSize+1)
+		assert.Equal(t, map[string]int{"users.info": 3}, calls)
+	})
+
+	t.Run("rejected batch falls back to single lookups", func(t *testing.T) {
+		calls := map[string]int{}
+		c := newUsersTestClient(t, calls)
+
+		got, err := c.GetUsers(context.Background(), []string{"U1", "UBAD"})
+
+		var se *SlackError
+		require.ErrorAs(t, err, &se)
+		assert.Equal(t, ErrNotFound, se.Code)
+		assert.Contains(t, se.Detail, "UBAD")
+		assert.Equal(t, []User{{ID: "U1", Name: "info-U1"}, {}}, got, "the users found are still returned")
+		assert.Equal(t, map[string]int{"users.info": 3}, calls)
+	})
+
+	t.Run("other failures end the lookup", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			reply func(cancel context.CancelFunc) string
+		}{
+			{"auth error", func(context.CancelFunc) string { return `{"ok":false,"error":"invalid_auth"}` }},
+			{"cancelled context", func(cancel context.CancelFunc) string {
+				cancel()
+				return `{"ok":false,"error":"user_not_found"}`
+			}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				calls := map[string]int{}
+				c := newTestClient(t, counted(calls, map[string]http.HandlerFunc{
+					"users.info": func(w http.ResponseWriter, r *http.Request) {
+						_, _ = w.Write([]byte(tt.reply(cancel)))
+					},
+				}))
+
+				_, err := c.GetUsers(ctx, ids(2*usersInfoBatchSize))
+
+				require.Error(t, err)
+				assert.Equal(t, map[string]int{"users.info": 1}, calls)
+			})
+		}
+	})
+}
@@ -0,0 +1,156 @@
+package slack
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+)
+
+// DefaultFindUsersLimit caps FindUsers results when no limit is given.
+const DefaultFindUsersLimit = 10
+
+// FindUsersParams is a user search. Query is matched against each user's
+// handle, display name, real name, email and title.
+type FindUsersParams struct {
+	Query          string
+	Limit          int
+	IncludeDeleted bool
+}
+
+// Match scores, best first. A field scores by how closely it matches the
+// query; a user scores by their best field.
+const (
+	scoreExact = 100 - 20*iota
+	scorePrefix
+	scoreWordPrefix
+	scoreSubstring
+	scoreSubsequence
+)
+
+// FindUsers searches the user directory, listed through svc so a cached
+// Service answers without calling Slack. Matching is case-insensitive and
+// forgiving: a query of three or more letters also matches a field holding
+// them in order, so "jdoe" finds Jane Doe. Results are ordered best match
+// first, active accounts before deactivated ones, then by handle.
+//
+// An email query that matches nobody in the directory is looked up with
+// users.lookupByEmail, since tokens without the users:read.email scope list
+// users without their addresses.
+func FindUsers(ctx context.Context, svc Service, params FindUsersParams) ([]User, error) {
+	query := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(params.Query), "@"))
+	if query == "" {
+		return nil, &SlackError{Code: ErrValidation, Message: "missing_query", Detail: "a search query is required"}
+	}
+	limit := params.Limit
+	if limit <= 0 {
+		limit = DefaultFindUsersLimit
+	}
+
+	type match struct {
+		user  User
+		score int
+	}
+	var matches []match
+	for u, err := range svc.IterUsers(ctx, PaginationParams{Limit: 200}) {
+		if err != nil {
+			return nil, err
+		}
+		if u.Deleted && !params.IncludeDeleted {
+			continue
+		}
+		if score := userScore(u, query); score > 0 {
+			matches = append(matches, match{u, score})
+		}
+	}
+
+	if len(matches) == 0 && strings.Contains(query, "@") {
+		u, err := svc.LookupUserByEmail(ctx, query)
+		var se *SlackError
+		switch {
+		case errors.As(err, &se) && se.Code == ErrNotFound:
+			return []User{}, nil
+		case err != nil:
+			return nil, err
+		case !u.Deleted || params.IncludeDeleted:
+			return []User{*u}, nil
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b match) int {
+		return cmp.Or(
+			cmp.Compare(b.score, a.score),
+			compareBool(a.user.Deleted, b.user.Deleted),
+			cmp.Compare(a.user.Name, b.user.Name),
+		)
+	})
+	users := []User{}
+	for _, m := range matches[:min(limit, len(matches))] {
+		users = append(users, m.user)
+	}
+	return users, nil
+}
+
+// compareBool orders false before true.
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	}
+	return -1
+}
+
+func userScore(u User, query string) int {
+	best := 0
+	for _, field := range []string{u.Name, u.DisplayName, u.RealName, u.Email, u.Title} {
+		best = max(best, fieldScore(strings.ToLower(field), query))
+	}
+	return best
+}
+
+func fieldScore(field, query string) int {
+	switch {
+	case field == "":
+		return 0
+	case field == query:
+		return scoreExact
+	case strings.HasPrefix(field, query):
+		return scorePrefix
+	case hasWordPrefix(field, query):
+		return scoreWordPrefix
+	case strings.Contains(field, query):
+		return scoreSubstring
+	case len(query) >= 3 && isSubsequence(field, query):
+		return scoreSubsequence
+	}
+	return 0
+}
+
+// hasWordPrefix reports whether a word of field, split on spaces and
+// punctuation, starts with query.
+func hasWordPrefix(field, query string) bool {
+	for i := 1; i < len(field); i++ {
+		if strings.ContainsRune(" .-_@", rune(field[i-1])) && strings.HasPrefix(field[i:], query) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSubsequence reports whether the letters of query appear in field in
+// order, ignoring spaces in query.
+func isSubsequence(field, query string) bool {
+	query = strings.ReplaceAll(query, " ", "")
+	for _, r := range field {
+		if query == "" {
+			break
+		}
+		if q := []rune(query)[0]; r == q {
+			query = query[len(string(q)):]
+		}
+	}
+	return query == ""
+}
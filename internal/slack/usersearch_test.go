@@ -0,0 +1,101 @@
+package slack
+
+import (
+	"context"
+	"iter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeUserDirectory lists users and looks them up by email, panicking on
+// anything else through the nil embedded Service.
+type fakeUserDirectory struct {
+	Service
+	users   []User
+	lookups int
+}
+
+func (f *fakeUserDirectory) IterUsers(ctx context.Context, params PaginationParams) iter.Seq2[User, error] {
+	return func(yield func(User, error) bool) {
+		for _, u := range f.users {
+			if !yield(u, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (f *fakeUserDirectory) LookupUserByEmail(ctx context.Context, email string) (*User, error) {
+	f.lookups++
+	if email == "carol@example.com" {
+		return &User{ID: "U3", Name: "carol", Email: email}, nil
+	}
+	return nil, &SlackError{Code: ErrNotFound, Message: "users_not_found"}
+}
+
+func newFakeUserDirectory() *fakeUserDirectory {
+	return &fakeUserDirectory{users: []User{
+		{ID: "U1", Name: "jdoe", RealName: "Jane Doe", DisplayName: "Jane", Title: "Staff Engineer"},
+		{ID: "U2", Name: "bob", RealName: "Bob Janeway", Email: "bob@example.com", Title: "Engineering Manager"},
+		{ID: "U4", Name: "jane.old", RealName: "Jane Doe", Deleted: true},
+		{ID: "U5", Name: "alice", RealName: "Alice Smith", Title: "Designer"},
+	}}
+}
+
+func TestFindUsers(t *testing.T) {
+	ids := func(users []User) []string {
+		out := []string{}
+		for _, u := range users {
+			out = append(out, u.ID)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name   string
+		params FindUsersParams
+		want   []string
+	}{
+		{"exact handle first", FindUsersParams{Query: "@jdoe"}, []string{"U1"}},
+		{"prefix before word prefix", FindUsersParams{Query: "jane"}, []string{"U1", "U2"}},
+		{"title word", FindUsersParams{Query: "engineer"}, []string{"U2", "U1"}},
+		{"email", FindUsersParams{Query: "BOB@example.com"}, []string{"U2"}},
+		{"letters in order", FindUsersParams{Query: "asmith"}, []string{"U5"}},
+		{"short queries need a real match", FindUsersParams{Query: "jd"}, []string{"U1"}},
+		{"deleted users on request", FindUsersParams{Query: "jane doe", IncludeDeleted: true}, []string{"U1", "U4"}},
+		{"limit", FindUsersParams{Query: "e", Limit: 2}, []string{"U2", "U1"}},
+		{"no match", FindUsersParams{Query: "zed"}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FindUsers(context.Background(), newFakeUserDirectory(), tt.params)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ids(got))
+		})
+	}
+
+	t.Run("unlisted email falls back to lookup", func(t *testing.T) {
+		dir := newFakeUserDirectory()
+
+		got, err := FindUsers(context.Background(), dir, FindUsersParams{Query: "carol@example.com"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"U3"}, ids(got))
+
+		got, err = FindUsers(context.Background(), dir, FindUsersParams{Query: "nobody@example.com"})
+		require.NoError(t, err)
+		assert.Empty(t, got)
+		assert.Equal(t, 2, dir.lookups)
+	})
+
+	t.Run("empty query", func(t *testing.T) {
+		_, err := FindUsers(context.Background(), newFakeUserDirectory(), FindUsersParams{Query: " @ "})
+
+		var se *SlackError
+		require.ErrorAs(t, err, &se)
+		assert.Equal(t, ErrValidation, se.Code)
+	})
+}